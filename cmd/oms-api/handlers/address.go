@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// clearOtherDefaults takes the default flags address holds away from the user's other addresses
+func clearOtherDefaults(tx *gorm.DB, address *models.Address) error {
+	if address.DefaultShipping {
+		if err := tx.Model(&models.Address{}).Where("user_id = ? AND id <> ? AND default_shipping", address.UserID, address.ID).
+			Update("default_shipping", false).Error; err != nil {
+			return err
+		}
+	}
+	if address.DefaultBilling {
+		if err := tx.Model(&models.Address{}).Where("user_id = ? AND id <> ? AND default_billing", address.UserID, address.ID).
+			Update("default_billing", false).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *OmsUserServiceServer) CreateAddress(ctx context.Context, req *pb.CreateAddressRequest) (*pb.Address, error) {
+	if err := authorizeOwner(ctx, models.PermUsersWrite, req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	// Validate the address
+	postal, err := models.PostalAddressFromPb(req.GetAddress())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid address: %v", err)
+	}
+	address := models.Address{
+		UserID:          req.GetUserId(),
+		Label:           req.GetAddress().GetLabel(),
+		PostalAddress:   postal,
+		DefaultShipping: req.GetAddress().GetDefaultShipping(),
+		DefaultBilling:  req.GetAddress().GetDefaultBilling(),
+	}
+
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Ensure the user exists
+		var user models.User
+		if err := tx.Where("id = ? AND deleted_at IS NULL", req.GetUserId()).First(&user).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Errorf(codes.NotFound, "User not found")
+			}
+			return status.Errorf(codes.Internal, "Unable to fetch user data: %v", err)
+		}
+
+		// The first address of a user becomes their default
+		var count int64
+		if err := tx.Model(&models.Address{}).Where("user_id = ?", address.UserID).Count(&count).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to count addresses: %v", err)
+		}
+		if count == 0 {
+			address.DefaultShipping = true
+			address.DefaultBilling = true
+		}
+
+		// Insert the address and move the default flags to it
+		if err := tx.Create(&address).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to insert address: %v", err)
+		}
+		if err := clearOtherDefaults(tx, &address); err != nil {
+			return status.Errorf(codes.Internal, "Failed to update default addresses: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return address.ToPb(), nil
+}
+
+func (s *OmsUserServiceServer) GetAddressesByUserId(ctx context.Context, req *pb.GetUserRequest) (*pb.GetAddressesResponse, error) {
+	if err := authorizeOwner(ctx, models.PermUsersRead, req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	var addresses []models.Address
+	if err := s.DB.WithContext(ctx).Where("user_id = ?", req.GetUserId()).Order("id").Find(&addresses).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to fetch addresses: %v", err)
+	}
+
+	response := &pb.GetAddressesResponse{}
+	for i := range addresses {
+		response.Addresses = append(response.Addresses, addresses[i].ToPb())
+	}
+	return response, nil
+}
+
+// UpdateAddress replaces an address book entry. Orders placed with it keep their copy.
+func (s *OmsUserServiceServer) UpdateAddress(ctx context.Context, req *pb.UpdateAddressRequest) (*pb.Address, error) {
+	// Validate the address
+	postal, err := models.PostalAddressFromPb(req.GetAddress())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid address: %v", err)
+	}
+
+	var address models.Address
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Find the address
+		if err := tx.First(&address, req.GetAddress().GetId()).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Errorf(codes.NotFound, "Address not found")
+			}
+			return status.Errorf(codes.Internal, "Failed to fetch address: %v", err)
+		}
+		if err := authorizeOwner(ctx, models.PermUsersWrite, address.UserID); err != nil {
+			return err
+		}
+
+		// Replace its fields and move the default flags to it
+		address.Label = req.GetAddress().GetLabel()
+		address.PostalAddress = postal
+		address.DefaultShipping = req.GetAddress().GetDefaultShipping()
+		address.DefaultBilling = req.GetAddress().GetDefaultBilling()
+		if err := tx.Save(&address).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to update address: %v", err)
+		}
+		if err := clearOtherDefaults(tx, &address); err != nil {
+			return status.Errorf(codes.Internal, "Failed to update default addresses: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return address.ToPb(), nil
+}
+
+// DeleteAddress removes an address book entry; orders placed with it keep their copy
+func (s *OmsUserServiceServer) DeleteAddress(ctx context.Context, req *pb.DeleteAddressRequest) (*pb.DeleteAddressResponse, error) {
+	// Find the owner of the address
+	var address models.Address
+	if err := s.DB.WithContext(ctx).Select("user_id").First(&address, req.GetAddressId()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Address not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to fetch address: %v", err)
+	}
+	if err := authorizeOwner(ctx, models.PermUsersWrite, address.UserID); err != nil {
+		return nil, err
+	}
+
+	// Clear the default flags so a deleted address is never picked as default
+	result := s.DB.WithContext(ctx).Model(&models.Address{}).Where("id = ?", req.GetAddressId()).
+		Updates(map[string]interface{}{"default_shipping": false, "default_billing": false})
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete address: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "Address not found")
+	}
+
+	// Proceed with soft delete
+	if err := s.DB.WithContext(ctx).Delete(&models.Address{}, req.GetAddressId()).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete address: %v", err)
+	}
+
+	return &pb.DeleteAddressResponse{Message: "Address deleted successfully"}, nil
+}
+
+// resolveOrderAddress returns the copy of the address an order is placed with: the user's address book
+// entry addressID, the inline address, or else the user's default address of kind. It returns nil when
+// none applies.
+func resolveOrderAddress(db *gorm.DB, userID int32, kind models.OrderAddressKind, addressID int32, inline *pb.Address) (*models.OrderAddress, error) {
+	if addressID != 0 && inline != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Give either a %s address ID or an inline %s address, not both", kind, kind)
+	}
+
+	// Copy an inline address as it is
+	if inline != nil {
+		postal, err := models.PostalAddressFromPb(inline)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid %s address: %v", kind, err)
+		}
+		return &models.OrderAddress{Kind: kind, Label: inline.GetLabel(), PostalAddress: postal}, nil
+	}
+
+	// Otherwise copy the requested or the default address book entry
+	query := db.Where("user_id = ?", userID)
+	if addressID != 0 {
+		query = query.Where("id = ?", addressID)
+	} else if kind == models.OrderAddressShipping {
+		query = query.Where("default_shipping")
+	} else {
+		query = query.Where("default_billing")
+	}
+	var address models.Address
+	if err := query.First(&address).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Println("Error fetching address:", err)
+			return nil, status.Errorf(codes.Internal, "Failed to fetch address")
+		}
+		if addressID != 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown %s address ID: %d", kind, addressID)
+		}
+		return nil, nil
+	}
+	orderAddress := models.OrderAddressFrom(kind, &address)
+	return &orderAddress, nil
+}
+
+// orderTaxRegion returns the region an order is taxed in: the requested region, or else the region of its
+// shipping address
+func orderTaxRegion(requested string, shipping *models.OrderAddress) string {
+	if region := models.NormalizeTaxRegion(requested); region != "" || shipping == nil {
+		return region
+	}
+	return shipping.TaxRegion()
+}
@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	// apiKeyHeader is the metadata key other systems send their API key in
+	apiKeyHeader = "x-api-key"
+	// apiKeyPrefixLength is how much of a key is kept in clear to tell keys apart
+	apiKeyPrefixLength = 12
+	// apiKeyUsageInterval is how often the last use of a key is written at most
+	apiKeyUsageInterval = time.Minute
+)
+
+// apiKeyFrom returns the API key of the "x-api-key" metadata
+func apiKeyFrom(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	for _, value := range md.Get(apiKeyHeader) {
+		if key := strings.TrimSpace(value); key != "" {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// authenticateAPIKey returns the API key as the caller, or an Unauthenticated error
+func (a *Authenticator) authenticateAPIKey(ctx context.Context, key string) (*Principal, error) {
+	var apiKey models.APIKey
+	err := a.DB.WithContext(ctx).Where("key_hash = ?", hashSecret(key)).First(&apiKey).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Println("Error fetching API key:", err)
+		return nil, status.Errorf(codes.Internal, "Failed to fetch API key")
+	}
+	now := time.Now()
+	if err != nil || !apiKey.Active(now) {
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid, expired or revoked API key")
+	}
+
+	// Track its use; a failure to do so does not fail the call
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= apiKeyUsageInterval {
+		if err := a.DB.WithContext(ctx).Model(&models.APIKey{}).Where("id = ?", apiKey.ID).
+			UpdateColumn("last_used_at", now).Error; err != nil {
+			log.Println("Error updating the last use of API key", apiKey.ID, ":", err)
+		}
+	}
+
+	return &Principal{APIKeyID: apiKey.ID, Scopes: apiKey.Permissions()}, nil
+}
+
+// parseAPIKeyScopes checks the requested scopes of a key are known permissions other than managing keys
+func parseAPIKeyScopes(values []string) ([]string, error) {
+	if len(values) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "At least one scope is required")
+	}
+	var scopes []string
+	for _, value := range values {
+		scope := models.Permission(strings.TrimSpace(value))
+		known := false
+		for _, permission := range models.Permissions {
+			known = known || permission == scope
+		}
+		if !known {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown scope: %q", value)
+		}
+		if scope == models.PermAPIKeysManage {
+			return nil, status.Errorf(codes.InvalidArgument, "API keys cannot manage API keys")
+		}
+		scopes = append(scopes, string(scope))
+	}
+	return scopes, nil
+}
+
+// APIKeyServiceServer implements the gRPC ApiKeyService
+type APIKeyServiceServer struct {
+	pb.UnimplementedApiKeyServiceServer
+	DB *gorm.DB
+}
+
+// CreateApiKey issues a key with the requested scopes. The response is the only one carrying the key.
+func (s *APIKeyServiceServer) CreateApiKey(ctx context.Context, req *pb.CreateApiKeyRequest) (*pb.CreateApiKeyResponse, error) {
+	// Validate the request
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "A name is required")
+	}
+	scopes, err := parseAPIKeyScopes(req.GetScopes())
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, err := parseTimeFilter("expires_at", req.GetExpiresAt())
+	if err != nil {
+		return nil, err
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "expires_at must be in the future")
+	}
+
+	// Generate the key
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to generate API key: %v", err)
+	}
+	key := "oms_" + base64.RawURLEncoding.EncodeToString(random)
+
+	apiKey := models.APIKey{
+		Name:      name,
+		Prefix:    key[:apiKeyPrefixLength],
+		KeyHash:   hashSecret(key),
+		Scopes:    strings.Join(scopes, ","),
+		ExpiresAt: expiresAt,
+	}
+	if principal, ok := PrincipalFromContext(ctx); ok {
+		apiKey.CreatedBy = principal.UserID
+	}
+	if err := s.DB.WithContext(ctx).Create(&apiKey).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to insert API key: %v", err)
+	}
+
+	return &pb.CreateApiKeyResponse{ApiKey: apiKey.ToPb(), Key: key}, nil
+}
+
+// RevokeApiKey stops a key from working; revoking it again changes nothing
+func (s *APIKeyServiceServer) RevokeApiKey(ctx context.Context, req *pb.RevokeApiKeyRequest) (*pb.ApiKey, error) {
+	if err := s.DB.WithContext(ctx).Model(&models.APIKey{}).Where("id = ? AND revoked_at IS NULL", req.GetApiKeyId()).
+		Update("revoked_at", time.Now()).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to revoke API key: %v", err)
+	}
+
+	var apiKey models.APIKey
+	if err := s.DB.WithContext(ctx).First(&apiKey, req.GetApiKeyId()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "API key not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to fetch API key: %v", err)
+	}
+	return apiKey.ToPb(), nil
+}
+
+func (s *APIKeyServiceServer) ListApiKeys(ctx context.Context, req *pb.ListApiKeysRequest) (*pb.ListApiKeysResponse, error) {
+	query := s.DB.WithContext(ctx).Order("id")
+	if !req.GetIncludeRevoked() {
+		query = query.Where("revoked_at IS NULL")
+	}
+	var apiKeys []models.APIKey
+	if err := query.Find(&apiKeys).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to fetch API keys: %v", err)
+	}
+
+	response := &pb.ListApiKeysResponse{}
+	for i := range apiKeys {
+		response.ApiKeys = append(response.ApiKeys, apiKeys[i].ToPb())
+	}
+	return response, nil
+}
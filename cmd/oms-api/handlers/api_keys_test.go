@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseAPIKeyScopes(t *testing.T) {
+	for _, test := range []struct {
+		values   []string
+		want     []string
+		wantCode codes.Code
+	}{
+		{[]string{"orders.read"}, []string{"orders.read"}, codes.OK},
+		{[]string{" orders.read ", "items.write"}, []string{"orders.read", "items.write"}, codes.OK},
+		{nil, nil, codes.InvalidArgument},
+		{[]string{"orders.read", "orders.delete"}, nil, codes.InvalidArgument},
+		{[]string{"Orders.Read"}, nil, codes.InvalidArgument},
+		{[]string{""}, nil, codes.InvalidArgument},
+		{[]string{"api_keys.manage"}, nil, codes.InvalidArgument},
+	} {
+		got, err := parseAPIKeyScopes(test.values)
+		if code := status.Code(err); code != test.wantCode {
+			t.Errorf("parseAPIKeyScopes(%q) error %v, want %v", test.values, err, test.wantCode)
+			continue
+		}
+		if strings.Join(got, ",") != strings.Join(test.want, ",") {
+			t.Errorf("parseAPIKeyScopes(%q) = %q, want %q", test.values, got, test.want)
+		}
+	}
+}
+
+func TestAPIKeyFrom(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		ctx    context.Context
+		want   string
+		wantOK bool
+	}{
+		{"no metadata", context.Background(), "", false},
+		{"no key", metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token")), "", false},
+		{"key", metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", " oms_secret ")), "oms_secret", true},
+		{"blank key first", metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", " ", "x-api-key", "oms_secret")), "oms_secret", true},
+	} {
+		if got, ok := apiKeyFrom(test.ctx); got != test.want || ok != test.wantOK {
+			t.Errorf("%s: got %q, %v, want %q, %v", test.name, got, ok, test.want, test.wantOK)
+		}
+	}
+}
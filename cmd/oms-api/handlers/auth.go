@@ -0,0 +1,503 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// DefaultAccessTokenTTL is how long access tokens are valid when not configured
+	DefaultAccessTokenTTL = 15 * time.Minute
+	// DefaultRefreshTokenTTL is how long a session lasts when not configured
+	DefaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+	tokenIssuer       = "oms"
+	minPasswordLength = 8
+	maxPasswordLength = 72 // bcrypt ignores anything longer
+)
+
+// publicMethods can be called without an access token
+var publicMethods = map[string]bool{
+	pb.AuthService_Login_FullMethodName:        true,
+	pb.AuthService_RefreshToken_FullMethodName: true,
+	pb.UserService_CreateUser_FullMethodName:   true,
+}
+
+// Principal is the authenticated caller of an RPC: a logged-in user, or another system with an API key
+type Principal struct {
+	UserID      int32
+	SessionID   int32
+	Role        models.Role
+	APIKeyID    int32               // Set instead of the user for calls made with an API key
+	Scopes      []models.Permission // Permissions of the API key
+	CertSubject string              // Subject of the verified client certificate, over mutual TLS
+}
+
+// Can reports whether the caller has the permission
+func (p *Principal) Can(permission models.Permission) bool {
+	if p.APIKeyID != 0 {
+		return models.PermissionsAllow(p.Scopes, permission)
+	}
+	return p.Role.Can(permission)
+}
+
+// String describes the caller in error messages
+func (p *Principal) String() string {
+	switch {
+	case p.APIKeyID != 0:
+		return fmt.Sprintf("API key %d", p.APIKeyID)
+	case p.UserID == 0 && p.CertSubject != "":
+		return fmt.Sprintf("Client certificate '%s'", p.CertSubject)
+	}
+	return fmt.Sprintf("Role '%s'", p.Role)
+}
+
+type principalKey struct{}
+
+// ContextWithPrincipal returns a copy of ctx carrying the caller
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the caller of the RPC, if it was authenticated
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok
+}
+
+// SigningKey is a named HMAC key for access tokens. The ID goes into the "kid" header of every token it
+// signs, so tokens keep verifying while the key is no longer the one signing.
+type SigningKey struct {
+	ID     string
+	Secret []byte
+}
+
+// ParseSigningKeys parses comma-separated "id:secret" pairs. The first key signs new tokens; the others
+// only verify tokens signed before a rotation, until those expire.
+func ParseSigningKeys(value string) ([]SigningKey, error) {
+	var keys []SigningKey
+	seen := map[string]bool{}
+	for _, pair := range strings.Split(value, ",") {
+		id, secret, found := strings.Cut(strings.TrimSpace(pair), ":")
+		if !found || id == "" || len(secret) < 32 {
+			return nil, fmt.Errorf("signing keys must be id:secret pairs with secrets of at least 32 characters")
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("duplicate signing key ID %q", id)
+		}
+		seen[id] = true
+		keys = append(keys, SigningKey{ID: id, Secret: []byte(secret)})
+	}
+	return keys, nil
+}
+
+// TokenIssuer signs and verifies access tokens and sets how long tokens last
+type TokenIssuer struct {
+	keys       []SigningKey
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+}
+
+// NewTokenIssuer creates an issuer signing with the first of keys
+func NewTokenIssuer(keys []SigningKey, accessTTL time.Duration, refreshTTL time.Duration) *TokenIssuer {
+	return &TokenIssuer{keys: keys, AccessTTL: accessTTL, RefreshTTL: refreshTTL}
+}
+
+// accessClaims are the claims of an access token; the subject is the user ID
+type accessClaims struct {
+	jwt.RegisteredClaims
+	SessionID int32 `json:"sid"`
+}
+
+func (t *TokenIssuer) issueAccessToken(userID int32, sessionID int32, now time.Time) (string, error) {
+	key := t.keys[0]
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    tokenIssuer,
+			Subject:   strconv.Itoa(int(userID)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(t.AccessTTL)),
+		},
+		SessionID: sessionID,
+	})
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.Secret)
+}
+
+// parseAccessToken verifies the signature and expiry of an access token and returns the user and session
+func (t *TokenIssuer) parseAccessToken(value string) (int32, int32, error) {
+	var claims accessClaims
+	_, err := jwt.ParseWithClaims(value, &claims, func(token *jwt.Token) (interface{}, error) {
+		id, _ := token.Header["kid"].(string)
+		for _, key := range t.keys {
+			if key.ID == id {
+				return key.Secret, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown signing key %q", id)
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithIssuer(tokenIssuer), jwt.WithExpirationRequired())
+	if err != nil {
+		return 0, 0, err
+	}
+	userID, err := strconv.ParseInt(claims.Subject, 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	return int32(userID), claims.SessionID, nil
+}
+
+// hashPassword checks the length of a password and returns its bcrypt hash
+func hashPassword(password string) (string, error) {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return "", status.Errorf(codes.InvalidArgument, "Password must be between %d and %d characters", minPasswordLength, maxPasswordLength)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		log.Println("Error hashing password:", err)
+		return "", status.Errorf(codes.Internal, "Failed to hash password")
+	}
+	return string(hash), nil
+}
+
+// dummyPasswordHash is compared against when a login names no user with a password, so such logins take
+// as long as wrong passwords
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("dummy-password"), bcrypt.DefaultCost)
+	return hash
+})
+
+// newRefreshToken returns a random refresh token and the hash stored for it
+func newRefreshToken() (string, string, error) {
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(random)
+	return token, hashSecret(token), nil
+}
+
+// hashSecret returns the SHA-256 hash stored for a refresh token or API key
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// checkLoginEmail fails when another user who can log in has the same email, so logins stay unambiguous
+func checkLoginEmail(tx *gorm.DB, email string, userID int32) error {
+	var count int64
+	if err := tx.Model(&models.User{}).Where("LOWER(email) = LOWER(?) AND password_hash <> '' AND id <> ?", email, userID).
+		Count(&count).Error; err != nil {
+		return status.Errorf(codes.Internal, "Failed to check email: %v", err)
+	}
+	if count > 0 {
+		return status.Errorf(codes.AlreadyExists, "Another user with a password has this email")
+	}
+	return nil
+}
+
+// revokeSessions ends every active session of a user
+func revokeSessions(tx *gorm.DB, userID int32) error {
+	if err := tx.Model(&models.Session{}).Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error; err != nil {
+		return status.Errorf(codes.Internal, "Failed to end sessions: %v", err)
+	}
+	return nil
+}
+
+// BootstrapAdmin makes sure there is an admin. While there is none, a user with email and password is
+// created as one. A user who already has the email is only made the admin when password is theirs, so
+// whoever registered the email first cannot become the admin.
+func BootstrapAdmin(db *gorm.DB, email string, password string) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var admins int64
+		if err := tx.Model(&models.User{}).Where("role = ?", models.RoleAdmin).Count(&admins).Error; err != nil {
+			return err
+		}
+		if admins > 0 {
+			return nil
+		}
+		if password == "" {
+			return fmt.Errorf("there is no admin yet; give a password to set up %s as one", email)
+		}
+
+		// Create the admin, or promote the user with the email when the password is theirs
+		var user models.User
+		err := tx.Where("LOWER(email) = LOWER(?)", email).Order("id").First(&user).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			hash, err := hashPassword(password)
+			if err != nil {
+				return err
+			}
+			user = models.User{Name: "Administrator", Email: email, PasswordHash: hash, Role: models.RoleAdmin}
+			if err := tx.Create(&user).Error; err != nil {
+				return err
+			}
+			if err := recordUserEvent(tx, models.EventUserCreated, user.ID); err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		default:
+			if user.PasswordHash == "" || bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)) != nil {
+				return fmt.Errorf("user %d already has the email %s and the password is not theirs; refusing to make them the admin", user.ID, email)
+			}
+			if err := tx.Model(&user).Update("role", models.RoleAdmin).Error; err != nil {
+				return err
+			}
+			if err := recordUserEvent(tx, models.EventUserUpdated, user.ID); err != nil {
+				return err
+			}
+		}
+		log.Printf("User %d (%s) is now an admin", user.ID, user.Email)
+		return nil
+	})
+}
+
+// AuthServiceServer implements the gRPC AuthService
+type AuthServiceServer struct {
+	pb.UnimplementedAuthServiceServer
+	DB     *gorm.DB
+	Tokens *TokenIssuer
+}
+
+// tokenResponse issues an access token for the session and returns it with the session's refresh token
+func (s *AuthServiceServer) tokenResponse(session *models.Session, refreshToken string) (*pb.TokenResponse, error) {
+	now := time.Now()
+	accessToken, err := s.Tokens.issueAccessToken(session.UserID, session.ID, now)
+	if err != nil {
+		log.Println("Error signing access token:", err)
+		return nil, status.Errorf(codes.Internal, "Failed to issue access token")
+	}
+	return &pb.TokenResponse{
+		AccessToken:      accessToken,
+		TokenType:        "Bearer",
+		ExpiresIn:        int64(s.Tokens.AccessTTL.Seconds()),
+		RefreshToken:     refreshToken,
+		RefreshExpiresIn: int64(session.ExpiresAt.Sub(now).Seconds()),
+	}, nil
+}
+
+// Login checks the email and password of a user and starts a session
+func (s *AuthServiceServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.TokenResponse, error) {
+	// Find the user who can log in with this email
+	var user models.User
+	err := s.DB.WithContext(ctx).Where("LOWER(email) = LOWER(?) AND password_hash <> ''", strings.TrimSpace(req.GetEmail())).
+		Order("id").First(&user).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch user: %v", err)
+	}
+
+	// Check the password; unknown users and wrong passwords fail alike
+	hash := dummyPasswordHash()
+	if err == nil {
+		hash = []byte(user.PasswordHash)
+	}
+	if bcrypt.CompareHashAndPassword(hash, []byte(req.GetPassword())) != nil || err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid email or password")
+	}
+
+	// Start the session
+	refreshToken, refreshHash, err := newRefreshToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to generate refresh token: %v", err)
+	}
+	session := models.Session{UserID: user.ID, RefreshTokenHash: refreshHash, ExpiresAt: time.Now().Add(s.Tokens.RefreshTTL)}
+	if err := s.DB.WithContext(ctx).Create(&session).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to insert session: %v", err)
+	}
+
+	return s.tokenResponse(&session, refreshToken)
+}
+
+// RefreshToken exchanges a refresh token for new tokens of the same session
+func (s *AuthServiceServer) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.TokenResponse, error) {
+	var session models.Session
+	var refreshToken string
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the session of the refresh token, so it is exchanged once
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("refresh_token_hash = ?", hashSecret(req.GetRefreshToken())).First(&session).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && !session.Active(time.Now())) {
+			return status.Errorf(codes.Unauthenticated, "Invalid or expired refresh token")
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "Failed to fetch session: %v", err)
+		}
+
+		// The user must still exist
+		var user models.User
+		if err := tx.First(&user, session.UserID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Errorf(codes.Unauthenticated, "Invalid or expired refresh token")
+			}
+			return status.Errorf(codes.Internal, "Failed to fetch user: %v", err)
+		}
+
+		// Replace the refresh token
+		var refreshHash string
+		if refreshToken, refreshHash, err = newRefreshToken(); err != nil {
+			return status.Errorf(codes.Internal, "Failed to generate refresh token: %v", err)
+		}
+		if err := tx.Model(&session).Update("refresh_token_hash", refreshHash).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to update session: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tokenResponse(&session, refreshToken)
+}
+
+// Logout ends the caller's session, or all of their sessions
+func (s *AuthServiceServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || principal.SessionID == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Only logged-in users can log out")
+	}
+
+	if req.GetAllSessions() {
+		if err := revokeSessions(s.DB.WithContext(ctx), principal.UserID); err != nil {
+			return nil, err
+		}
+		return &pb.LogoutResponse{Message: "Logged out of every session"}, nil
+	}
+
+	if err := s.DB.WithContext(ctx).Model(&models.Session{}).Where("id = ? AND revoked_at IS NULL", principal.SessionID).
+		Update("revoked_at", time.Now()).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to end session: %v", err)
+	}
+	return &pb.LogoutResponse{Message: "Logged out"}, nil
+}
+
+// Authenticator checks the access token or API key of every RPC except the public ones, and puts the
+// caller into the context of the handler
+type Authenticator struct {
+	DB     *gorm.DB
+	Tokens *TokenIssuer
+	// CertRole is the role of callers identified only by a verified client certificate; when empty, they
+	// need an access token or API key as well
+	CertRole models.Role
+}
+
+// bearerToken returns the token of the "authorization" metadata
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	for _, value := range md.Get("authorization") {
+		scheme, token, found := strings.Cut(value, " ")
+		if found && strings.EqualFold(scheme, "Bearer") && strings.TrimSpace(token) != "" {
+			return strings.TrimSpace(token), true
+		}
+	}
+	return "", false
+}
+
+// authenticate returns ctx with the caller of method, or an Unauthenticated error. The subject of a
+// verified client certificate is kept with whichever credential identified the caller.
+func (a *Authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if publicMethods[method] || strings.HasPrefix(method, "/grpc.reflection.") {
+		return ctx, nil
+	}
+
+	subject, hasCert := clientCertSubject(ctx)
+	var principal *Principal
+	var err error
+	if key, ok := apiKeyFrom(ctx); ok {
+		// Other systems send an API key
+		principal, err = a.authenticateAPIKey(ctx, key)
+	} else if token, ok := bearerToken(ctx); ok {
+		principal, err = a.authenticateToken(ctx, token)
+	} else if hasCert && a.CertRole != "" {
+		// or are known by their client certificate alone
+		principal = &Principal{Role: a.CertRole}
+	} else {
+		err = status.Errorf(codes.Unauthenticated, "Missing bearer token or API key")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	principal.CertSubject = subject
+	return ContextWithPrincipal(ctx, principal), nil
+}
+
+// authenticateToken returns the user and session of an access token
+func (a *Authenticator) authenticateToken(ctx context.Context, token string) (*Principal, error) {
+	userID, sessionID, err := a.Tokens.parseAccessToken(token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid access token")
+	}
+
+	// Its session must not have ended, nor its user been deleted; the role is read on every call, so role
+	// changes apply right away
+	var session struct {
+		models.Session
+		Role models.Role
+	}
+	result := a.DB.WithContext(ctx).Model(&models.Session{}).Select("sessions.*, users.role").
+		Joins("JOIN users ON users.id = sessions.user_id AND users.deleted_at IS NULL").
+		Where("sessions.id = ?", sessionID).Scan(&session)
+	if result.Error != nil {
+		log.Println("Error fetching session:", result.Error)
+		return nil, status.Errorf(codes.Internal, "Failed to fetch session")
+	}
+	if result.RowsAffected == 0 || session.UserID != userID || !session.Active(time.Now()) {
+		return nil, status.Errorf(codes.Unauthenticated, "Session has ended")
+	}
+
+	return &Principal{UserID: userID, SessionID: sessionID, Role: session.Role}, nil
+}
+
+// UnaryInterceptor authenticates unary RPCs
+func (a *Authenticator) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor authenticates streaming RPCs
+func (a *Authenticator) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+}
+
+// contextStream is a server stream whose handler sees ctx
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
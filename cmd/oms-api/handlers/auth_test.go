@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestParseSigningKeys(t *testing.T) {
+	secret := strings.Repeat("s", 32)
+	for _, test := range []struct {
+		value   string
+		wantIDs []string
+		wantErr bool
+	}{
+		{"current:" + secret, []string{"current"}, false},
+		{"new:" + secret + ", old:" + secret + "x", []string{"new", "old"}, false},
+		{"current:" + secret + ":with:colons", []string{"current"}, false},
+		{"", nil, true},
+		{secret, nil, true},
+		{":" + secret, nil, true},
+		{"short:" + secret[1:], nil, true},
+		{"same:" + secret + ",same:" + secret, nil, true},
+	} {
+		keys, err := ParseSigningKeys(test.value)
+		if (err != nil) != test.wantErr {
+			t.Errorf("ParseSigningKeys(%q) error %v, want error %v", test.value, err, test.wantErr)
+			continue
+		}
+		var ids []string
+		for _, key := range keys {
+			ids = append(ids, key.ID)
+		}
+		if strings.Join(ids, ",") != strings.Join(test.wantIDs, ",") {
+			t.Errorf("ParseSigningKeys(%q) = %v, want %v", test.value, ids, test.wantIDs)
+		}
+	}
+}
+
+func TestAccessTokenKeyRotation(t *testing.T) {
+	oldKey := SigningKey{ID: "2024-01", Secret: []byte(strings.Repeat("o", 32))}
+	newKey := SigningKey{ID: "2024-06", Secret: []byte(strings.Repeat("n", 32))}
+	beforeRotation := NewTokenIssuer([]SigningKey{oldKey}, time.Hour, DefaultRefreshTokenTTL)
+	duringRotation := NewTokenIssuer([]SigningKey{newKey, oldKey}, time.Hour, DefaultRefreshTokenTTL)
+	afterRotation := NewTokenIssuer([]SigningKey{newKey}, time.Hour, DefaultRefreshTokenTTL)
+	now := time.Now()
+
+	issue := func(issuer *TokenIssuer) string {
+		token, err := issuer.issueAccessToken(7, 3, now)
+		if err != nil {
+			t.Fatalf("issueAccessToken: %v", err)
+		}
+		return token
+	}
+	oldToken, newToken := issue(beforeRotation), issue(duringRotation)
+
+	// The first key signs, and its ID names it
+	parsed, _, err := jwt.NewParser().ParseUnverified(newToken, &accessClaims{})
+	if err != nil {
+		t.Fatalf("ParseUnverified: %v", err)
+	}
+	if parsed.Header["kid"] != newKey.ID {
+		t.Errorf("token signed by key %v, want %s", parsed.Header["kid"], newKey.ID)
+	}
+
+	for _, test := range []struct {
+		name    string
+		issuer  *TokenIssuer
+		token   string
+		wantErr bool
+	}{
+		{"old token before rotation", beforeRotation, oldToken, false},
+		{"old token during rotation", duringRotation, oldToken, false},
+		{"new token during rotation", duringRotation, newToken, false},
+		{"old token after the old key is dropped", afterRotation, oldToken, true},
+		{"new token with only the old key", beforeRotation, newToken, true},
+		{"new token claiming the old key", duringRotation, withKeyID(t, newToken, oldKey.ID), true},
+	} {
+		userID, sessionID, err := test.issuer.parseAccessToken(test.token)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: error %v, want error %v", test.name, err, test.wantErr)
+			continue
+		}
+		if err == nil && (userID != 7 || sessionID != 3) {
+			t.Errorf("%s: got user %d session %d, want 7 and 3", test.name, userID, sessionID)
+		}
+	}
+}
+
+// withKeyID replaces the header of token with one naming signing key kid, leaving claims and signature
+func withKeyID(t *testing.T, token string, kid string) string {
+	t.Helper()
+	header := jwt.New(jwt.SigningMethodHS256)
+	header.Header["kid"] = kid
+	signed, err := header.SignedString([]byte("irrelevant"))
+	if err != nil {
+		t.Fatalf("SignedString: %v", err)
+	}
+	return signed[:strings.Index(signed, ".")] + token[strings.Index(token, "."):]
+}
+
+func TestAccessTokenExpiry(t *testing.T) {
+	key := SigningKey{ID: "current", Secret: []byte(strings.Repeat("k", 32))}
+	issuer := NewTokenIssuer([]SigningKey{key}, 15*time.Minute, DefaultRefreshTokenTTL)
+	sign := func(claims jwt.Claims, method jwt.SigningMethod) string {
+		token := jwt.NewWithClaims(method, claims)
+		token.Header["kid"] = key.ID
+		signed, err := token.SignedString(key.Secret)
+		if err != nil {
+			t.Fatalf("SignedString: %v", err)
+		}
+		return signed
+	}
+	now := time.Now()
+	valid := jwt.RegisteredClaims{Issuer: tokenIssuer, Subject: "7", ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute))}
+	noExpiry, otherIssuer := valid, valid
+	noExpiry.ExpiresAt = nil
+	otherIssuer.Issuer = "someone-else"
+
+	issue := func(at time.Time) string {
+		token, err := issuer.issueAccessToken(7, 3, at)
+		if err != nil {
+			t.Fatalf("issueAccessToken: %v", err)
+		}
+		return token
+	}
+
+	for _, test := range []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{"fresh", issue(now), false},
+		{"within its lifetime", issue(now.Add(-14 * time.Minute)), false},
+		{"expired", issue(now.Add(-16 * time.Minute)), true},
+		{"without expiry", sign(accessClaims{RegisteredClaims: noExpiry}, jwt.SigningMethodHS256), true},
+		{"from another issuer", sign(accessClaims{RegisteredClaims: otherIssuer}, jwt.SigningMethodHS256), true},
+		{"other algorithm", sign(accessClaims{RegisteredClaims: valid}, jwt.SigningMethodHS512), true},
+		{"signed the same way", sign(accessClaims{RegisteredClaims: valid}, jwt.SigningMethodHS256), false},
+	} {
+		if _, _, err := issuer.parseAccessToken(test.token); (err != nil) != test.wantErr {
+			t.Errorf("%s: error %v, want error %v", test.name, err, test.wantErr)
+		}
+	}
+}
@@ -0,0 +1,454 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// isCheckoutFailure reports whether err fails a checkout step for good, so the saga compensates. Other
+// errors, e.g. a lost database connection, leave the step to be retried.
+func isCheckoutFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition, codes.ResourceExhausted, codes.AlreadyExists:
+		return true
+	}
+	return false
+}
+
+// advanceCheckout records the outcome of the current step of saga inside tx and moves the saga to its next
+// step, writing the given extra columns with it. A failed step starts the compensations.
+func advanceCheckout(tx *gorm.DB, saga *models.CheckoutSaga, result models.CheckoutStepResult, detail string, columns map[string]interface{}) error {
+	// Lock the saga and make sure nobody else advanced it meanwhile
+	var current models.CheckoutSaga
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&current, saga.ID).Error; err != nil {
+		log.Println("Error locking checkout:", err)
+		return status.Errorf(codes.Internal, "Failed to fetch checkout")
+	}
+	if current.Status != saga.Status || current.Step != saga.Step {
+		return status.Errorf(codes.Aborted, "Checkout %d was advanced by another run", saga.ID)
+	}
+
+	// Record the step
+	step := models.CheckoutStep{SagaID: saga.ID, Name: saga.Step, Result: result, Detail: detail}
+	if err := tx.Create(&step).Error; err != nil {
+		log.Println("Error inserting checkout step:", err)
+		return status.Errorf(codes.Internal, "Failed to record checkout step")
+	}
+
+	// Move on to the next step, or to the compensations
+	if columns == nil {
+		columns = map[string]interface{}{}
+	}
+	switch {
+	case saga.Status == models.CheckoutStatusRunning && result == models.CheckoutStepFailed:
+		columns["status"] = models.CheckoutStatusCompensating
+		columns["step"] = models.CheckoutCompensations[0]
+		columns["error"] = detail
+	case saga.Status == models.CheckoutStatusRunning:
+		columns["step"] = models.NextCheckoutStep(models.CheckoutSteps, saga.Step)
+		if columns["step"] == models.CheckoutStepName("") {
+			columns["status"] = models.CheckoutStatusCompleted
+		}
+	default:
+		columns["step"] = models.NextCheckoutStep(models.CheckoutCompensations, saga.Step)
+		if columns["step"] == models.CheckoutStepName("") {
+			columns["status"] = models.CheckoutStatusFailed
+		}
+	}
+	if err := tx.Model(&models.CheckoutSaga{}).Where("id = ?", saga.ID).Updates(columns).Error; err != nil {
+		log.Println("Error updating checkout:", err)
+		return status.Errorf(codes.Internal, "Failed to update checkout")
+	}
+	if err := tx.First(saga, saga.ID).Error; err != nil {
+		log.Println("Error fetching checkout:", err)
+		return status.Errorf(codes.Internal, "Failed to fetch checkout")
+	}
+	return nil
+}
+
+// reserveCheckoutStock reserves the stock of a draft order. Pending orders reserved theirs when they were
+// placed, which the checkout leaves alone.
+func (s *OrderServiceServer) reserveCheckoutStock(ctx context.Context, saga *models.CheckoutSaga) error {
+	return s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		order, err := lockOrder(tx, saga.OrderID)
+		if err != nil {
+			return err
+		}
+		switch order.Status {
+		case models.OrderStatusPending:
+			return advanceCheckout(tx, saga, models.CheckoutStepSkipped, "Stock was reserved when the order was placed", nil)
+		case models.OrderStatusDraft:
+			if err := transitionOrder(tx, &order, models.OrderStatusPending, fmt.Sprintf("Stock reserved by checkout %d", saga.ID)); err != nil {
+				return err
+			}
+			return advanceCheckout(tx, saga, models.CheckoutStepDone, "Reserved stock", map[string]interface{}{"reserved_stock": true})
+		}
+		return status.Errorf(codes.FailedPrecondition, "Only draft and pending orders can be checked out; order is '%s'", order.Status)
+	})
+}
+
+// authorizeCheckoutPayment authorizes the order's payment, unless one is authorized already
+func (s *OrderServiceServer) authorizeCheckoutPayment(ctx context.Context, saga *models.CheckoutSaga) error {
+	// Use a payment authorized by an earlier run of the checkout that stopped before recording it, or one
+	// authorized outside the checkout; only the former, tagged with the checkout, is the checkout's to void
+	var payments []models.Payment
+	if err := s.DB.WithContext(ctx).Where("order_id = ? AND status IN ?", saga.OrderID, []models.PaymentStatus{models.PaymentStatusAuthorized, models.PaymentStatusCaptured}).
+		Order("id").Find(&payments).Error; err != nil {
+		log.Println("Error fetching payments:", err)
+		return status.Errorf(codes.Internal, "Failed to fetch payments")
+	}
+	for _, payment := range payments {
+		if payment.CheckoutID != nil && *payment.CheckoutID == saga.ID {
+			return s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+				return advanceCheckout(tx, saga, models.CheckoutStepDone, fmt.Sprintf("Authorized payment %d", payment.ID), map[string]interface{}{"payment_id": payment.ID})
+			})
+		}
+	}
+	if len(payments) > 0 {
+		return s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			return advanceCheckout(tx, saga, models.CheckoutStepSkipped, fmt.Sprintf("Payment %d was authorized outside the checkout", payments[0].ID), nil)
+		})
+	}
+
+	// Otherwise authorize the order's final price with the given payment method
+	if saga.PaymentMethod == "" {
+		return status.Errorf(codes.FailedPrecondition, "Order %d has no authorized payment and no payment method was given", saga.OrderID)
+	}
+	authorized, err := s.Payments.authorizePayment(ctx, saga.OrderID, saga.PaymentMethod, &saga.ID)
+	if err != nil {
+		return err
+	}
+	if authorized.GetStatus() != pb.PaymentStatus_PAYMENT_STATUS_AUTHORIZED {
+		return status.Errorf(codes.FailedPrecondition, "Payment %d was not authorized: %s", authorized.GetId(), authorized.GetFailureReason())
+	}
+	return s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return advanceCheckout(tx, saga, models.CheckoutStepDone, fmt.Sprintf("Authorized payment %d", authorized.GetId()), map[string]interface{}{"payment_id": authorized.GetId()})
+	})
+}
+
+// captureCheckoutPayment takes the money the order's authorized payment holds, so the refunds of later
+// returns have captured money to give back. Only a capture the checkout made is refunded when a later step
+// fails.
+func (s *OrderServiceServer) captureCheckoutPayment(ctx context.Context, saga *models.CheckoutSaga) error {
+	// Capture the payment the checkout authorized, or else the one authorized outside it
+	var payments []models.Payment
+	if err := s.DB.WithContext(ctx).Where("order_id = ? AND status IN ?", saga.OrderID, []models.PaymentStatus{models.PaymentStatusAuthorized, models.PaymentStatusCaptured}).
+		Order("id").Find(&payments).Error; err != nil {
+		log.Println("Error fetching payments:", err)
+		return status.Errorf(codes.Internal, "Failed to fetch payments")
+	}
+	if len(payments) == 0 {
+		return status.Errorf(codes.FailedPrecondition, "Order %d has no authorized payment to capture", saga.OrderID)
+	}
+	payment := payments[0]
+	for _, candidate := range payments {
+		if saga.PaymentID != nil && candidate.ID == *saga.PaymentID {
+			payment = candidate
+		}
+	}
+
+	// A payment captured since the checkout started was captured by an earlier run that stopped before
+	// recording it; one captured before is not the checkout's to refund
+	if payment.Status == models.PaymentStatusCaptured {
+		var captures int64
+		if err := s.DB.WithContext(ctx).Model(&models.PaymentAttempt{}).Where("payment_id = ? AND operation = ? AND result = ? AND created_at >= ?",
+			payment.ID, models.PaymentOperationCapture, models.PaymentAttemptSucceeded, saga.CreatedAt).Count(&captures).Error; err != nil {
+			log.Println("Error counting payment attempts:", err)
+			return status.Errorf(codes.Internal, "Failed to fetch payment attempts")
+		}
+		return s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if captures > 0 {
+				return advanceCheckout(tx, saga, models.CheckoutStepDone, fmt.Sprintf("Captured payment %d", payment.ID), map[string]interface{}{"captured_payment_id": payment.ID})
+			}
+			return advanceCheckout(tx, saga, models.CheckoutStepSkipped, fmt.Sprintf("Payment %d was captured outside the checkout", payment.ID), nil)
+		})
+	}
+
+	captured, err := s.Payments.CapturePayment(ctx, &pb.CapturePaymentRequest{PaymentId: payment.ID})
+	if err != nil {
+		return err
+	}
+	if captured.GetStatus() != pb.PaymentStatus_PAYMENT_STATUS_CAPTURED {
+		reason := "no answer"
+		if attempts := captured.GetAttempts(); len(attempts) > 0 {
+			reason = attempts[len(attempts)-1].GetError()
+		}
+		return status.Errorf(codes.FailedPrecondition, "Payment %d was not captured: %s", payment.ID, reason)
+	}
+	return s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return advanceCheckout(tx, saga, models.CheckoutStepDone, fmt.Sprintf("Captured payment %d", payment.ID), map[string]interface{}{"captured_payment_id": payment.ID})
+	})
+}
+
+// confirmCheckoutOrder confirms the order, which commits its stock reservation
+func (s *OrderServiceServer) confirmCheckoutOrder(ctx context.Context, saga *models.CheckoutSaga) error {
+	return s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		order, err := lockOrder(tx, saga.OrderID)
+		if err != nil {
+			return err
+		}
+		if order.Status == models.OrderStatusConfirmed {
+			return advanceCheckout(tx, saga, models.CheckoutStepSkipped, "Order was already confirmed", nil)
+		}
+		if err := transitionOrder(tx, &order, models.OrderStatusConfirmed, fmt.Sprintf("Order confirmed by checkout %d", saga.ID)); err != nil {
+			return err
+		}
+		return advanceCheckout(tx, saga, models.CheckoutStepDone, "Confirmed order", nil)
+	})
+}
+
+// refundCheckoutPayment refunds the capture the checkout made
+func (s *OrderServiceServer) refundCheckoutPayment(ctx context.Context, saga *models.CheckoutSaga) error {
+	if saga.CapturedPaymentID == nil {
+		return s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			return advanceCheckout(tx, saga, models.CheckoutStepSkipped, "The checkout captured no payment", nil)
+		})
+	}
+
+	payment, err := loadPayment(s.DB.WithContext(ctx), *saga.CapturedPaymentID)
+	if err != nil {
+		return err
+	}
+	detail := fmt.Sprintf("Payment %d is '%s'; nothing to refund", payment.ID, payment.Status)
+	result := models.CheckoutStepSkipped
+	if payment.Status.CanTransitionTo(models.PaymentStatusRefunded) && payment.Refundable().MinorUnits > 0 {
+		refunded, err := s.Payments.RefundPayment(ctx, &pb.RefundPaymentRequest{PaymentId: payment.ID})
+		if err != nil {
+			return err
+		}
+		if refunded.GetStatus() != pb.PaymentStatus_PAYMENT_STATUS_REFUNDED {
+			return status.Errorf(codes.Unavailable, "Payment %d could not be refunded yet", payment.ID)
+		}
+		detail, result = fmt.Sprintf("Refunded payment %d", payment.ID), models.CheckoutStepDone
+	}
+	return s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return advanceCheckout(tx, saga, result, detail, nil)
+	})
+}
+
+// voidCheckoutPayment voids the payment the checkout authorized
+func (s *OrderServiceServer) voidCheckoutPayment(ctx context.Context, saga *models.CheckoutSaga) error {
+	if saga.PaymentID == nil {
+		return s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			return advanceCheckout(tx, saga, models.CheckoutStepSkipped, "The checkout authorized no payment", nil)
+		})
+	}
+
+	payment, err := loadPayment(s.DB.WithContext(ctx), *saga.PaymentID)
+	if err != nil {
+		return err
+	}
+	detail := fmt.Sprintf("Payment %d is '%s'; nothing to void", payment.ID, payment.Status)
+	result := models.CheckoutStepSkipped
+	if payment.Status == models.PaymentStatusAuthorized {
+		voided, err := s.Payments.VoidPayment(ctx, &pb.VoidPaymentRequest{PaymentId: payment.ID})
+		if err != nil {
+			return err
+		}
+		if voided.GetStatus() != pb.PaymentStatus_PAYMENT_STATUS_VOIDED {
+			return status.Errorf(codes.Unavailable, "Payment %d could not be voided yet", payment.ID)
+		}
+		detail, result = fmt.Sprintf("Voided payment %d", payment.ID), models.CheckoutStepDone
+	}
+	return s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return advanceCheckout(tx, saga, result, detail, nil)
+	})
+}
+
+// releaseCheckoutStock releases the reservation the checkout made, moving the order back to draft
+func (s *OrderServiceServer) releaseCheckoutStock(ctx context.Context, saga *models.CheckoutSaga) error {
+	return s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if !saga.ReservedStock {
+			return advanceCheckout(tx, saga, models.CheckoutStepSkipped, "Stock was reserved before the checkout", nil)
+		}
+		order, err := lockOrder(tx, saga.OrderID)
+		if err != nil {
+			return err
+		}
+		if order.Status != models.OrderStatusPending {
+			return advanceCheckout(tx, saga, models.CheckoutStepSkipped, fmt.Sprintf("Order is '%s'; no reservation to release", order.Status), nil)
+		}
+		if err := transitionOrder(tx, &order, models.OrderStatusDraft, fmt.Sprintf("Reservation released by failed checkout %d", saga.ID)); err != nil {
+			return err
+		}
+		return advanceCheckout(tx, saga, models.CheckoutStepDone, "Released stock reservation", nil)
+	})
+}
+
+// runCheckout drives saga until it completes or has been compensated. A step failing for good starts the
+// compensations; any other error stops the saga where it is, for ResumeCheckouts to retry.
+func (s *OrderServiceServer) runCheckout(ctx context.Context, saga *models.CheckoutSaga) error {
+	steps := map[models.CheckoutStepName]func(context.Context, *models.CheckoutSaga) error{
+		models.CheckoutStepReserveStock:     s.reserveCheckoutStock,
+		models.CheckoutStepAuthorizePayment: s.authorizeCheckoutPayment,
+		models.CheckoutStepCapturePayment:   s.captureCheckoutPayment,
+		models.CheckoutStepConfirmOrder:     s.confirmCheckoutOrder,
+		models.CheckoutStepRefundPayment:    s.refundCheckoutPayment,
+		models.CheckoutStepVoidPayment:      s.voidCheckoutPayment,
+		models.CheckoutStepReleaseStock:     s.releaseCheckoutStock,
+	}
+	for saga.Status.IsActive() {
+		step, found := steps[saga.Step]
+		if !found {
+			return status.Errorf(codes.Internal, "Checkout %d is at unknown step '%s'", saga.ID, saga.Step)
+		}
+		err := step(ctx, saga)
+		if err == nil {
+			continue
+		}
+		// Compensations are retried until they succeed
+		if saga.Status != models.CheckoutStatusRunning || !isCheckoutFailure(err) {
+			return err
+		}
+
+		// Record the failure and start compensating
+		if err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			return advanceCheckout(tx, saga, models.CheckoutStepFailed, status.Convert(err).Message(), nil)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadCheckout fetches a checkout with its steps
+func loadCheckout(db *gorm.DB, sagaID int32) (models.CheckoutSaga, error) {
+	var saga models.CheckoutSaga
+	if err := db.Preload("Steps", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id")
+	}).First(&saga, sagaID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return saga, status.Errorf(codes.NotFound, "Checkout not found")
+		}
+		return saga, status.Errorf(codes.Internal, "Failed to fetch checkout: %v", err)
+	}
+	return saga, nil
+}
+
+// Checkout confirms an order through the checkout saga; retries carrying the same idempotency key replay the first response
+func (s *OrderServiceServer) Checkout(ctx context.Context, req *pb.CheckoutRequest) (*pb.CheckoutResponse, error) {
+	if err := authorizeOrder(ctx, s.DB, models.PermOrdersWrite, req.GetOrderId()); err != nil {
+		return nil, err
+	}
+	key := idempotencyKeyFrom(ctx, req.GetIdempotencyKey())
+	return idempotent(ctx, s.DB, s.IdempotencyTTL, pb.OrderService_Checkout_FullMethodName, key, req, func() (*pb.CheckoutResponse, error) {
+		return s.checkout(ctx, req.GetOrderId(), strings.TrimSpace(req.GetPaymentMethod()))
+	})
+}
+
+func (s *OrderServiceServer) checkout(ctx context.Context, orderID int32, paymentMethod string) (*pb.CheckoutResponse, error) {
+	// Start the saga; an order is checked out by one saga at a time
+	saga := models.CheckoutSaga{
+		OrderID:       orderID,
+		Status:        models.CheckoutStatusRunning,
+		Step:          models.CheckoutSteps[0],
+		PaymentMethod: paymentMethod,
+	}
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		order, err := lockOrder(tx, orderID)
+		if err != nil {
+			return err
+		}
+		if !order.Status.IsEditable() {
+			return status.Errorf(codes.FailedPrecondition, "Only draft and pending orders can be checked out; order is '%s'", order.Status)
+		}
+
+		var running int64
+		if err := tx.Model(&models.CheckoutSaga{}).Where("order_id = ? AND status IN ?", orderID,
+			[]models.CheckoutStatus{models.CheckoutStatusRunning, models.CheckoutStatusCompensating}).Count(&running).Error; err != nil {
+			log.Println("Error counting checkouts:", err)
+			return status.Errorf(codes.Internal, "Failed to fetch checkouts")
+		}
+		if running > 0 {
+			return status.Errorf(codes.Aborted, "A checkout of order %d is already running", orderID)
+		}
+
+		if err := tx.Create(&saga).Error; err != nil {
+			log.Println("Error inserting checkout:", err)
+			return status.Errorf(codes.Internal, "Failed to insert checkout")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Run it to the end
+	if err := s.runCheckout(ctx, &saga); err != nil {
+		return nil, err
+	}
+
+	// Return the saga with the order status it left
+	finished, err := loadCheckout(s.DB.WithContext(ctx), saga.ID)
+	if err != nil {
+		return nil, err
+	}
+	var order models.Order
+	if err := s.DB.WithContext(ctx).Select("status").First(&order, orderID).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch order: %v", err)
+	}
+	return &pb.CheckoutResponse{Checkout: finished.ToPb(), CurrentStatus: order.Status.ToPb()}, nil
+}
+
+func (s *OrderServiceServer) GetCheckout(ctx context.Context, req *pb.GetCheckoutRequest) (*pb.CheckoutSaga, error) {
+	if err := authorizeOrder(ctx, s.DB, models.PermOrdersRead, req.GetOrderId()); err != nil {
+		return nil, err
+	}
+
+	var latest models.CheckoutSaga
+	if err := s.DB.WithContext(ctx).Select("id").Where("order_id = ?", req.GetOrderId()).Order("id DESC").First(&latest).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Order %d has no checkout", req.GetOrderId())
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to fetch checkout: %v", err)
+	}
+
+	saga, err := loadCheckout(s.DB.WithContext(ctx), latest.ID)
+	if err != nil {
+		return nil, err
+	}
+	return saga.ToPb(), nil
+}
+
+// ResumeCheckouts continues the checkouts nobody drives anymore, e.g. because the process running them
+// stopped. A checkout counts as abandoned once it has not advanced for twice the payment timeout. It
+// returns how many checkouts it ran to the end.
+func (s *OrderServiceServer) ResumeCheckouts(ctx context.Context) (int, error) {
+	var sagas []models.CheckoutSaga
+	if err := s.DB.WithContext(ctx).Where("status IN ? AND updated_at < ?",
+		[]models.CheckoutStatus{models.CheckoutStatusRunning, models.CheckoutStatusCompensating},
+		time.Now().Add(-2*s.Payments.timeout())).Order("id").Find(&sagas).Error; err != nil {
+		return 0, err
+	}
+
+	resumed := 0
+	for i := range sagas {
+		// Claim the saga, so other replicas leave it alone
+		claim := s.DB.WithContext(ctx).Model(&models.CheckoutSaga{}).Where("id = ? AND updated_at = ?", sagas[i].ID, sagas[i].UpdatedAt).
+			Update("updated_at", time.Now())
+		if claim.Error != nil {
+			return resumed, claim.Error
+		}
+		if claim.RowsAffected == 0 {
+			continue
+		}
+
+		if err := s.runCheckout(ctx, &sagas[i]); err != nil {
+			log.Printf("Checkout %d of order %d stopped at '%s': %v", sagas[i].ID, sagas[i].OrderID, sagas[i].Step, err)
+			continue
+		}
+		resumed++
+	}
+	return resumed, nil
+}
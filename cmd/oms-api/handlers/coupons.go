@@ -0,0 +1,263 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// priceOrder evaluates the discount rules, and the coupon with couponCode when one is given, against
+// order.Items, taxes the lines and sets the prices, tax, coupon, adjustments and exchange rates of order.
+// order.Items must already be priced with converter. It must run inside the transaction that
+// writes the order so the coupon stays locked until its redemption is recorded.
+func priceOrder(tx *gorm.DB, order *models.Order, couponCode string, now time.Time, converter *currencyConverter, taxes TaxCalculator) (models.Discounts, error) {
+	discounts, err := calculateDiscounts(tx, *order, order.Items, now, converter)
+	if err != nil {
+		return discounts, err
+	}
+
+	if couponCode == "" {
+		order.CouponID = nil
+		order.CouponCode = ""
+	} else if err := applyCoupon(tx, order, couponCode, now, &discounts, true, converter); err != nil {
+		return discounts, err
+	}
+
+	order.TotalPrice = models.NewMoney(orderSubtotal(order.Items), discounts.Currency)
+	if err := applyTax(tx, order, discounts, taxes); err != nil {
+		return discounts, err
+	}
+	order.Adjustments = models.AdjustmentsFromDiscounts(discounts)
+	order.ExchangeRates = converter.snapshot()
+	return discounts, nil
+}
+
+// replaceOrderBreakdown swaps the persisted adjustments and exchange rates of an existing order for
+// order.Adjustments and order.ExchangeRates
+func replaceOrderBreakdown(tx *gorm.DB, order *models.Order) error {
+	if err := tx.Where("order_id = ?", order.ID).Delete(&models.OrderAdjustment{}).Error; err != nil {
+		log.Println("Error deleting order adjustments:", err)
+		return status.Errorf(codes.Internal, "Failed to update order adjustments")
+	}
+	if len(order.Adjustments) > 0 {
+		for i := range order.Adjustments {
+			order.Adjustments[i].OrderID = order.ID
+		}
+		if err := tx.Create(&order.Adjustments).Error; err != nil {
+			log.Println("Error inserting order adjustments:", err)
+			return status.Errorf(codes.Internal, "Failed to update order adjustments")
+		}
+	}
+
+	if err := tx.Where("order_id = ?", order.ID).Delete(&models.OrderExchangeRate{}).Error; err != nil {
+		log.Println("Error deleting order exchange rates:", err)
+		return status.Errorf(codes.Internal, "Failed to update order exchange rates")
+	}
+	if len(order.ExchangeRates) > 0 {
+		for i := range order.ExchangeRates {
+			order.ExchangeRates[i].OrderID = order.ID
+		}
+		if err := tx.Create(&order.ExchangeRates).Error; err != nil {
+			log.Println("Error inserting order exchange rates:", err)
+			return status.Errorf(codes.Internal, "Failed to update order exchange rates")
+		}
+	}
+	return nil
+}
+
+// orderSubtotal sums the price of every line before discounts, in minor units
+func orderSubtotal(items []models.OrderItem) int64 {
+	var subtotal int64
+	for _, item := range items {
+		subtotal += item.Subtotal()
+	}
+	return subtotal
+}
+
+// applyCoupon validates the coupon with code for order and adds its discount to discounts. With lock the
+// coupon row is locked until the transaction ends, so concurrent orders cannot redeem it past its limits;
+// quotes evaluate it without locking. Amounts on the coupon are converted into the order currency. An order
+// that already holds the coupon keeps it: its validity window and limits are not checked again, only the
+// conditions that depend on the order lines.
+func applyCoupon(tx *gorm.DB, order *models.Order, code string, now time.Time, discounts *models.Discounts, lock bool, converter *currencyConverter) error {
+	code = models.NormalizeCouponCode(code)
+
+	// Lock the coupon so its redemption count cannot change under us. Deleted coupons are inactive, but
+	// orders that already hold one keep it.
+	query := tx.Unscoped()
+	if lock {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+	var coupon models.Coupon
+	if err := query.Preload("EligibleItems").Where("code = ?", code).First(&coupon).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Errorf(codes.FailedPrecondition, "Coupon %s does not exist", code)
+		}
+		log.Println("Error fetching coupon", code, ":", err)
+		return status.Errorf(codes.Internal, "Failed to fetch coupon")
+	}
+
+	alreadyHeld := order.CouponID != nil && *order.CouponID == coupon.ID
+	if !alreadyHeld {
+		// Check how often this customer has used the coupon already
+		var used int64
+		if coupon.MaxRedemptionsPerUser > 0 {
+			if err := tx.Model(&models.CouponRedemption{}).Where("coupon_id = ? AND user_id = ?", coupon.ID, order.UserID).Count(&used).Error; err != nil {
+				log.Println("Error counting coupon redemptions:", err)
+				return status.Errorf(codes.Internal, "Failed to fetch coupon redemptions")
+			}
+		}
+		if err := checkCouponRedeemable(&coupon, now, used); err != nil {
+			return err
+		}
+	}
+
+	// Bring the amounts on the coupon into the order currency
+	minOrderValue := models.NewMoney(0, discounts.Currency)
+	if coupon.MinOrderValue.MinorUnits > 0 {
+		converted, err := converter.convert(coupon.MinOrderValue)
+		if err != nil {
+			return err
+		}
+		minOrderValue = converted
+	}
+	fixedAmount := models.NewMoney(0, discounts.Currency)
+	if coupon.Kind == models.DiscountKindFixed {
+		converted, err := converter.convert(coupon.Amount)
+		if err != nil {
+			return err
+		}
+		fixedAmount = converted
+	}
+
+	amount, err := couponAmount(&coupon, order.Items, minOrderValue, fixedAmount, discounts.TotalDiscountAmount)
+	if err != nil {
+		return err
+	}
+
+	applied := models.AppliedDiscount{
+		CouponID: coupon.ID,
+		Name:     "Coupon " + coupon.Code,
+		Kind:     coupon.Kind,
+		Scope:    models.DiscountScopeOrder,
+		Amount:   amount,
+		Reason:   coupon.Describe(),
+	}
+	for _, eligible := range coupon.EligibleItems {
+		applied.ItemIDs = append(applied.ItemIDs, eligible.ItemID)
+	}
+	discounts.Applied = append(discounts.Applied, applied)
+	discounts.TotalDiscountAmount += amount
+
+	order.CouponID = &coupon.ID
+	order.CouponCode = coupon.Code
+	return nil
+}
+
+// checkCouponRedeemable fails unless coupon is active at now and within its limits, given how often the
+// customer has used it already
+func checkCouponRedeemable(coupon *models.Coupon, now time.Time, usedByCustomer int64) error {
+	if !coupon.Active || coupon.DeletedAt.Valid {
+		return status.Errorf(codes.FailedPrecondition, "Coupon %s is no longer active", coupon.Code)
+	}
+	if coupon.StartsAt != nil && now.Before(*coupon.StartsAt) {
+		return status.Errorf(codes.FailedPrecondition, "Coupon %s is not valid before %s", coupon.Code, coupon.StartsAt.Format(time.RFC3339))
+	}
+	if coupon.EndsAt != nil && now.After(*coupon.EndsAt) {
+		return status.Errorf(codes.FailedPrecondition, "Coupon %s expired on %s", coupon.Code, coupon.EndsAt.Format(time.RFC3339))
+	}
+	if coupon.MaxRedemptions > 0 && coupon.RedemptionCount >= coupon.MaxRedemptions {
+		return status.Errorf(codes.FailedPrecondition, "Coupon %s has reached its redemption limit", coupon.Code)
+	}
+	if coupon.MaxRedemptionsPerUser > 0 && usedByCustomer >= int64(coupon.MaxRedemptionsPerUser) {
+		return status.Errorf(codes.FailedPrecondition, "Coupon %s can be used at most %d time(s) per customer", coupon.Code, coupon.MaxRedemptionsPerUser)
+	}
+	return nil
+}
+
+// couponAmount returns what coupon takes off the order lines items, in minor units of the order currency.
+// minOrderValue and fixedAmount are the coupon's amounts in that currency and discounted is what the
+// order is already discounted by.
+func couponAmount(coupon *models.Coupon, items []models.OrderItem, minOrderValue, fixedAmount models.Money, discounted int64) (int64, error) {
+	subtotal := orderSubtotal(items)
+	if subtotal < minOrderValue.MinorUnits {
+		return 0, status.Errorf(codes.FailedPrecondition, "Coupon %s requires an order value of at least %s", coupon.Code, minOrderValue)
+	}
+
+	// Only eligible lines count towards the discounted amount
+	var eligibleSubtotal int64
+	for _, item := range items {
+		if coupon.IsItemEligible(item.ItemID) {
+			eligibleSubtotal += item.Price.MinorUnits * int64(item.Quantity)
+		}
+	}
+	if eligibleSubtotal == 0 {
+		return 0, status.Errorf(codes.FailedPrecondition, "Coupon %s does not apply to any item in this order", coupon.Code)
+	}
+
+	// The coupon never takes the order below zero; percentages are rounded like discount rules
+	amount := fixedAmount.MinorUnits
+	if coupon.Kind == models.DiscountKindPercentage {
+		amount = models.PercentOf(eligibleSubtotal, coupon.Value)
+	}
+	if amount > eligibleSubtotal {
+		amount = eligibleSubtotal
+	}
+	if remaining := subtotal - discounted; amount > remaining {
+		amount = remaining
+	}
+	return amount, nil
+}
+
+// redeemCoupon records the redemption of the coupon now held by order, giving back previousCouponID when
+// the order held a different coupon before. It must run in the transaction that called applyCoupon.
+func redeemCoupon(tx *gorm.DB, order *models.Order, previousCouponID *int32) error {
+	if previousCouponID != nil && order.CouponID != nil && *previousCouponID == *order.CouponID {
+		return nil
+	}
+
+	if previousCouponID != nil {
+		if err := releaseCoupon(tx, order.ID, *previousCouponID); err != nil {
+			return err
+		}
+	}
+	if order.CouponID == nil {
+		return nil
+	}
+
+	redemption := models.CouponRedemption{CouponID: *order.CouponID, UserID: order.UserID, OrderID: order.ID}
+	if err := tx.Create(&redemption).Error; err != nil {
+		log.Println("Error inserting coupon redemption:", err)
+		return status.Errorf(codes.Internal, "Failed to record coupon redemption")
+	}
+	if err := tx.Unscoped().Model(&models.Coupon{}).Where("id = ?", *order.CouponID).
+		Update("redemption_count", gorm.Expr("redemption_count + 1")).Error; err != nil {
+		log.Println("Error updating coupon redemption count:", err)
+		return status.Errorf(codes.Internal, "Failed to record coupon redemption")
+	}
+	return nil
+}
+
+// releaseCoupon gives back the redemption of a coupon by an order, so it counts against the limits no longer
+func releaseCoupon(tx *gorm.DB, orderID, couponID int32) error {
+	result := tx.Where("order_id = ? AND coupon_id = ?", orderID, couponID).Delete(&models.CouponRedemption{})
+	if result.Error != nil {
+		log.Println("Error deleting coupon redemption:", result.Error)
+		return status.Errorf(codes.Internal, "Failed to release coupon redemption")
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+	if err := tx.Unscoped().Model(&models.Coupon{}).Where("id = ?", couponID).
+		Update("redemption_count", gorm.Expr("redemption_count - ?", result.RowsAffected)).Error; err != nil {
+		log.Println("Error updating coupon redemption count:", err)
+		return status.Errorf(codes.Internal, "Failed to release coupon redemption")
+	}
+	return nil
+}
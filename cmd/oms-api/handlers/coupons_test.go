@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func TestCheckCouponRedeemable(t *testing.T) {
+	now := time.Date(2024, 12, 10, 12, 0, 0, 0, time.UTC)
+	earlier, later := now.Add(-time.Hour), now.Add(time.Hour)
+	coupon := func(change func(*models.Coupon)) *models.Coupon {
+		c := &models.Coupon{Code: "WINTER", Kind: models.DiscountKindPercentage, Value: 10, Active: true}
+		change(c)
+		return c
+	}
+
+	for _, test := range []struct {
+		name   string
+		coupon *models.Coupon
+		used   int64
+		want   codes.Code
+	}{
+		{"active", coupon(func(*models.Coupon) {}), 0, codes.OK},
+		{"inactive", coupon(func(c *models.Coupon) { c.Active = false }), 0, codes.FailedPrecondition},
+		{"deleted", coupon(func(c *models.Coupon) { c.DeletedAt = gorm.DeletedAt{Time: earlier, Valid: true} }), 0, codes.FailedPrecondition},
+		{"within its window", coupon(func(c *models.Coupon) { c.StartsAt, c.EndsAt = &earlier, &later }), 0, codes.OK},
+		{"not started", coupon(func(c *models.Coupon) { c.StartsAt = &later }), 0, codes.FailedPrecondition},
+		{"expired", coupon(func(c *models.Coupon) { c.EndsAt = &earlier }), 0, codes.FailedPrecondition},
+		{"valid from now", coupon(func(c *models.Coupon) { c.StartsAt = &now }), 0, codes.OK},
+		{"valid until now", coupon(func(c *models.Coupon) { c.EndsAt = &now }), 0, codes.OK},
+		{"below the redemption limit", coupon(func(c *models.Coupon) { c.MaxRedemptions, c.RedemptionCount = 3, 2 }), 0, codes.OK},
+		{"at the redemption limit", coupon(func(c *models.Coupon) { c.MaxRedemptions, c.RedemptionCount = 3, 3 }), 0, codes.FailedPrecondition},
+		{"below the customer limit", coupon(func(c *models.Coupon) { c.MaxRedemptionsPerUser = 2 }), 1, codes.OK},
+		{"at the customer limit", coupon(func(c *models.Coupon) { c.MaxRedemptionsPerUser = 2 }), 2, codes.FailedPrecondition},
+		{"no customer limit", coupon(func(*models.Coupon) {}), 50, codes.OK},
+	} {
+		if got := status.Code(checkCouponRedeemable(test.coupon, now, test.used)); got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestCouponAmount(t *testing.T) {
+	items := []models.OrderItem{
+		{ItemID: 1, Quantity: 1, Price: models.NewMoney(5000, "USD")}, // 50.00
+		{ItemID: 2, Quantity: 10, Price: models.NewMoney(333, "USD")}, // 33.30
+	} // Subtotal 83.30
+	none := models.NewMoney(0, "USD")
+	percent := func(value float64, eligible ...int32) *models.Coupon {
+		c := &models.Coupon{Code: "WINTER", Kind: models.DiscountKindPercentage, Value: value}
+		for _, id := range eligible {
+			c.EligibleItems = append(c.EligibleItems, models.CouponItem{ItemID: id})
+		}
+		return c
+	}
+	fixed := &models.Coupon{Code: "TENOFF", Kind: models.DiscountKindFixed, Amount: models.NewMoney(1000, "USD")}
+
+	for _, test := range []struct {
+		name          string
+		coupon        *models.Coupon
+		minOrderValue models.Money
+		fixedAmount   models.Money
+		discounted    int64
+		want          int64
+		wantCode      codes.Code
+	}{
+		{"percentage of the subtotal", percent(10), none, none, 0, 833, codes.OK},
+		{"percentages round half away from zero", percent(1.5), none, none, 0, 125, codes.OK}, // 124.95 cents
+		{"percentage of eligible lines only", percent(10, 2), none, none, 0, 333, codes.OK},
+		{"no eligible line", percent(10, 99), none, none, 0, 0, codes.FailedPrecondition},
+		{"fixed amount", fixed, none, models.NewMoney(1000, "USD"), 0, 1000, codes.OK},
+		{"fixed amount trimmed to eligible lines", &models.Coupon{Code: "MUGS", Kind: models.DiscountKindFixed,
+			EligibleItems: []models.CouponItem{{ItemID: 2}}}, none, models.NewMoney(5000, "USD"), 0, 3330, codes.OK},
+		{"trimmed to what earlier discounts left", fixed, none, models.NewMoney(1000, "USD"), 8000, 330, codes.OK},
+		{"minimum order value reached", percent(10), models.NewMoney(8330, "USD"), none, 0, 833, codes.OK},
+		{"minimum order value missed", percent(10), models.NewMoney(8331, "USD"), none, 0, 0, codes.FailedPrecondition},
+	} {
+		got, err := couponAmount(test.coupon, items, test.minOrderValue, test.fixedAmount, test.discounted)
+		if code := status.Code(err); code != test.wantCode {
+			t.Errorf("%s: got %v, want %v", test.name, code, test.wantCode)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("%s: got %d, want %d", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// currencyConverter converts amounts into the currency an order is priced in. It looks rates up in the
+// exchange-rate table, directly or inverted, and remembers every rate it used so the order can snapshot them.
+type currencyConverter struct {
+	db     *gorm.DB
+	target string
+	used   map[string]models.ExchangeRate // Rates into target, by base currency
+}
+
+func newCurrencyConverter(db *gorm.DB, target string) *currencyConverter {
+	return &currencyConverter{db: db, target: target, used: map[string]models.ExchangeRate{}}
+}
+
+// newOrderCurrencyConverter converts into the currency of an order that was already priced with rates. It
+// keeps using those rates, so editing the order does not re-price it at the rates of the day; only
+// currencies the order did not need before are looked up in the rate table.
+func newOrderCurrencyConverter(db *gorm.DB, target string, rates []models.OrderExchangeRate) *currencyConverter {
+	converter := newCurrencyConverter(db, target)
+	for _, rate := range rates {
+		if rate.QuoteCurrency != target {
+			continue
+		}
+		converter.used[rate.BaseCurrency] = models.ExchangeRate{
+			BaseCurrency:  rate.BaseCurrency,
+			QuoteCurrency: rate.QuoteCurrency,
+			Rate:          rate.Rate,
+			UpdatedAt:     rate.RateUpdatedAt,
+		}
+	}
+	return converter
+}
+
+// rateFrom returns the rate converting base into the target currency
+func (c *currencyConverter) rateFrom(base string) (models.ExchangeRate, error) {
+	if rate, ok := c.used[base]; ok {
+		return rate, nil
+	}
+
+	var rates []models.ExchangeRate
+	if err := c.db.Where("(base_currency = ? AND quote_currency = ?) OR (base_currency = ? AND quote_currency = ?)",
+		base, c.target, c.target, base).Find(&rates).Error; err != nil {
+		log.Println("Error fetching exchange rate:", err)
+		return models.ExchangeRate{}, status.Errorf(codes.Internal, "Failed to fetch exchange rate")
+	}
+
+	// Prefer the rate quoted in the direction we convert
+	var rate *models.ExchangeRate
+	for i := range rates {
+		if rates[i].BaseCurrency == base {
+			rate = &rates[i]
+		} else if rate == nil {
+			inverse := rates[i].Inverse()
+			rate = &inverse
+		}
+	}
+	if rate == nil {
+		return models.ExchangeRate{}, status.Errorf(codes.FailedPrecondition, "No exchange rate from %s to %s", base, c.target)
+	}
+
+	c.used[base] = *rate
+	return *rate, nil
+}
+
+// convert converts amount into the target currency
+func (c *currencyConverter) convert(amount models.Money) (models.Money, error) {
+	if amount.Currency == c.target {
+		return amount, nil
+	}
+	rate, err := c.rateFrom(amount.Currency)
+	if err != nil {
+		return models.Money{}, err
+	}
+	return rate.Convert(amount), nil
+}
+
+// itemPrice returns the price of item in the target currency: its explicit price in that currency when it
+// has one, otherwise its base price converted
+func (c *currencyConverter) itemPrice(item *models.Item) (models.Money, error) {
+	if item.Price.Currency == c.target {
+		return item.Price, nil
+	}
+
+	var explicit models.ItemPrice
+	err := c.db.Where("item_id = ? AND currency = ?", item.ID, c.target).First(&explicit).Error
+	if err == nil {
+		return explicit.Money(), nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Println("Error fetching item price:", err)
+		return models.Money{}, status.Errorf(codes.Internal, "Failed to fetch item price")
+	}
+	return c.convert(item.Price)
+}
+
+// snapshot returns the rates used so far, to be stored with the order
+func (c *currencyConverter) snapshot() []models.OrderExchangeRate {
+	var snapshot []models.OrderExchangeRate
+	for _, rate := range c.used {
+		snapshot = append(snapshot, models.OrderExchangeRate{
+			BaseCurrency:  rate.BaseCurrency,
+			QuoteCurrency: rate.QuoteCurrency,
+			Rate:          rate.Rate,
+			RateUpdatedAt: rate.UpdatedAt,
+		})
+	}
+	return snapshot
+}
+
+// resolveOrderCurrency validates the currency requested for an order, defaulting to the default currency
+func resolveOrderCurrency(currency string) (string, error) {
+	if currency == "" {
+		return models.DefaultCurrency, nil
+	}
+	currency, err := models.NormalizeCurrency(currency)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "Invalid currency: %v", err)
+	}
+	return currency, nil
+}
+
+// CurrencyServiceServer implements the gRPC CurrencyService
+type CurrencyServiceServer struct {
+	pb.UnimplementedCurrencyServiceServer
+	DB *gorm.DB
+}
+
+// upsertExchangeRates creates the rates, replacing the rates of pairs that already exist
+func upsertExchangeRates(db *gorm.DB, rates []models.ExchangeRate) error {
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "base_currency"}, {Name: "quote_currency"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+	}).Create(&rates).Error
+}
+
+func (s *CurrencyServiceServer) SetExchangeRate(ctx context.Context, req *pb.SetExchangeRateRequest) (*pb.ExchangeRate, error) {
+	// Validate and convert the rate
+	rate, err := models.ExchangeRateFromPb(req.GetRate())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid exchange rate: %v", err)
+	}
+
+	// Create the rate or replace the existing one
+	if err := upsertExchangeRates(s.DB.WithContext(ctx), []models.ExchangeRate{rate}); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to save exchange rate: %v", err)
+	}
+
+	// Read it back for the timestamps
+	if err := s.DB.WithContext(ctx).Where("base_currency = ? AND quote_currency = ?", rate.BaseCurrency, rate.QuoteCurrency).First(&rate).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch exchange rate: %v", err)
+	}
+	return rate.ToPb(), nil
+}
+
+func (s *CurrencyServiceServer) GetAllExchangeRates(ctx context.Context, req *pb.GetAllExchangeRatesRequest) (*pb.GetAllExchangeRatesResponse, error) {
+	var rates []models.ExchangeRate
+	if err := s.DB.WithContext(ctx).Order("base_currency, quote_currency").Find(&rates).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to fetch exchange rates: %v", err)
+	}
+
+	response := &pb.GetAllExchangeRatesResponse{}
+	for i := range rates {
+		response.Rates = append(response.Rates, rates[i].ToPb())
+	}
+	return response, nil
+}
+
+// ImportExchangeRates loads rates from CSV. The import is all or nothing: one invalid line rejects the file.
+func (s *CurrencyServiceServer) ImportExchangeRates(ctx context.Context, req *pb.ImportExchangeRatesRequest) (*pb.ImportExchangeRatesResponse, error) {
+	reader := csv.NewReader(strings.NewReader(req.GetCsv()))
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	// Parse and validate every line before writing anything
+	var rates []models.ExchangeRate
+	seen := map[string]int{}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid CSV: %v", err)
+		}
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "base_currency") {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Line %d: rate must be a number", line)
+		}
+		rate, err := models.ExchangeRateFromPb(&pb.ExchangeRate{BaseCurrency: record[0], QuoteCurrency: record[1], Rate: value})
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Line %d: %v", line, err)
+		}
+
+		// A pair listed twice keeps its last rate
+		pair := rate.BaseCurrency + "/" + rate.QuoteCurrency
+		if i, ok := seen[pair]; ok {
+			rates[i] = rate
+			continue
+		}
+		seen[pair] = len(rates)
+		rates = append(rates, rate)
+	}
+	if len(rates) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "The CSV contains no exchange rates")
+	}
+
+	// Write all rates in one statement
+	if err := upsertExchangeRates(s.DB.WithContext(ctx), rates); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to save exchange rates: %v", err)
+	}
+
+	return &pb.ImportExchangeRatesResponse{Imported: int32(len(rates))}, nil
+}
+
+func (s *CurrencyServiceServer) DeleteExchangeRate(ctx context.Context, req *pb.DeleteExchangeRateRequest) (*pb.DeleteExchangeRateResponse, error) {
+	base := strings.ToUpper(strings.TrimSpace(req.GetBaseCurrency()))
+	quote := strings.ToUpper(strings.TrimSpace(req.GetQuoteCurrency()))
+
+	result := s.DB.WithContext(ctx).Where("base_currency = ? AND quote_currency = ?", base, quote).Delete(&models.ExchangeRate{})
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete exchange rate: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "Exchange rate not found")
+	}
+
+	return &pb.DeleteExchangeRateResponse{Message: "Exchange rate deleted successfully"}, nil
+}
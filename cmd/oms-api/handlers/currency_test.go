@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+)
+
+func TestOrderCurrencyConverterUsesSnapshotRates(t *testing.T) {
+	updated := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	snapshot := []models.OrderExchangeRate{
+		{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: 0.9, RateUpdatedAt: updated},
+		{BaseCurrency: "JPY", QuoteCurrency: "EUR", Rate: 0.0062, RateUpdatedAt: updated},
+		{BaseCurrency: "EUR", QuoteCurrency: "GBP", Rate: 0.85, RateUpdatedAt: updated}, // Into another currency; ignored
+	}
+	// Without a database, every conversion must come from the snapshot
+	converter := newOrderCurrencyConverter(nil, "EUR", snapshot)
+
+	for _, test := range []struct {
+		amount models.Money
+		want   models.Money
+	}{
+		{models.NewMoney(1999, "USD"), models.NewMoney(1799, "EUR")},
+		{models.NewMoney(1000, "JPY"), models.NewMoney(620, "EUR")},
+		{models.NewMoney(1234, "EUR"), models.NewMoney(1234, "EUR")},
+	} {
+		got, err := converter.convert(test.amount)
+		if err != nil {
+			t.Fatalf("convert(%s): %v", test.amount, err)
+		}
+		if got != test.want {
+			t.Errorf("convert(%s) = %s, want %s", test.amount, got, test.want)
+		}
+	}
+
+	// The order keeps the rates it was priced with, as they were
+	kept := map[string]models.OrderExchangeRate{}
+	for _, rate := range converter.snapshot() {
+		kept[rate.BaseCurrency] = rate
+	}
+	if len(kept) != 2 {
+		t.Fatalf("snapshot has %d rates, want the 2 into EUR", len(kept))
+	}
+	for _, rate := range snapshot[:2] {
+		got := kept[rate.BaseCurrency]
+		if got.QuoteCurrency != "EUR" || got.Rate != rate.Rate || !got.RateUpdatedAt.Equal(updated) {
+			t.Errorf("snapshot rate from %s = %+v, want %+v", rate.BaseCurrency, got, rate)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// DiscountServiceServer implements the gRPC DiscountService
+type DiscountServiceServer struct {
+	pb.UnimplementedDiscountServiceServer
+	DB *gorm.DB
+}
+
+func (s *DiscountServiceServer) CreateDiscountRule(ctx context.Context, req *pb.CreateDiscountRuleRequest) (*pb.DiscountRule, error) {
+	// Validate and convert the rule definition
+	rule, err := models.DiscountRuleFromPb(req.GetRule())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid discount rule: %v", err)
+	}
+
+	// Insert the new rule
+	if err := s.DB.WithContext(ctx).Create(&rule).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to insert discount rule: %v", err)
+	}
+
+	return rule.ToPb(), nil
+}
+
+func (s *DiscountServiceServer) GetDiscountRuleById(ctx context.Context, req *pb.GetDiscountRuleRequest) (*pb.DiscountRule, error) {
+	var rule models.DiscountRule
+	if err := s.DB.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", req.GetId()).First(&rule).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "Discount rule not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to fetch discount rule: %v", err)
+	}
+
+	return rule.ToPb(), nil
+}
+
+func (s *DiscountServiceServer) GetAllDiscountRules(ctx context.Context, req *pb.GetAllDiscountRulesRequest) (*pb.GetAllDiscountRulesResponse, error) {
+	// Fetch the rules in the order they are evaluated
+	query := s.DB.WithContext(ctx).Order("priority DESC, id")
+	if req.GetActiveOnly() {
+		query = query.Where("active = ?", true)
+	}
+
+	var rules []models.DiscountRule
+	if err := query.Find(&rules).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to fetch discount rules: %v", err)
+	}
+
+	response := &pb.GetAllDiscountRulesResponse{}
+	for i := range rules {
+		response.Rules = append(response.Rules, rules[i].ToPb())
+	}
+	return response, nil
+}
+
+func (s *DiscountServiceServer) UpdateDiscountRuleById(ctx context.Context, req *pb.UpdateDiscountRuleRequest) (*pb.DiscountRule, error) {
+	// Find the existing rule
+	var existing models.DiscountRule
+	if err := s.DB.WithContext(ctx).First(&existing, req.GetRule().GetId()).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "Discount rule not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to fetch discount rule: %v", err)
+	}
+
+	// Validate and convert the new definition
+	rule, err := models.DiscountRuleFromPb(req.GetRule())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid discount rule: %v", err)
+	}
+	rule.ID = existing.ID
+	rule.CreatedAt = existing.CreatedAt
+
+	// Replace the stored definition
+	if err := s.DB.WithContext(ctx).Save(&rule).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to update discount rule: %v", err)
+	}
+
+	return rule.ToPb(), nil
+}
+
+func (s *DiscountServiceServer) DeleteDiscountRuleById(ctx context.Context, req *pb.DeleteDiscountRuleRequest) (*pb.DeleteDiscountRuleResponse, error) {
+	// Attempt to find the rule, including soft-deleted rules
+	var rule models.DiscountRule
+	if err := s.DB.WithContext(ctx).Unscoped().First(&rule, req.GetId()).Error; err != nil {
+		return nil, status.Errorf(codes.NotFound, "Discount rule not found: %v", err)
+	}
+
+	// Check if the rule is already soft-deleted
+	if rule.DeletedAt.Valid {
+		return &pb.DeleteDiscountRuleResponse{Message: "Discount rule is already deleted"}, nil
+	}
+
+	// Proceed with soft delete
+	if err := s.DB.WithContext(ctx).Delete(&rule).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete discount rule: %v", err)
+	}
+
+	return &pb.DeleteDiscountRuleResponse{Message: "Discount rule deleted successfully"}, nil
+}
+
+func (s *DiscountServiceServer) CreateCoupon(ctx context.Context, req *pb.CreateCouponRequest) (*pb.Coupon, error) {
+	// Validate and convert the coupon definition
+	coupon, err := models.CouponFromPb(req.GetCoupon())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid coupon: %v", err)
+	}
+
+	// Ensure every eligible item exists
+	for _, item := range coupon.EligibleItems {
+		var count int64
+		if err := s.DB.WithContext(ctx).Model(&models.Item{}).Where("id = ?", item.ItemID).Count(&count).Error; err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to fetch item: %v", err)
+		}
+		if count == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown item ID: %d", item.ItemID)
+		}
+	}
+
+	// Reject codes that are already taken, including by deleted coupons
+	var existing int64
+	if err := s.DB.WithContext(ctx).Unscoped().Model(&models.Coupon{}).Where("code = ?", coupon.Code).Count(&existing).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch coupon: %v", err)
+	}
+	if existing > 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "Coupon %s already exists", coupon.Code)
+	}
+
+	// Insert the coupon together with its eligible items
+	if err := s.DB.WithContext(ctx).Create(&coupon).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to insert coupon: %v", err)
+	}
+
+	return coupon.ToPb(), nil
+}
+
+func (s *DiscountServiceServer) GetCoupon(ctx context.Context, req *pb.GetCouponRequest) (*pb.Coupon, error) {
+	var coupon models.Coupon
+	if err := s.DB.WithContext(ctx).Preload("EligibleItems").Where("code = ?", models.NormalizeCouponCode(req.GetCode())).First(&coupon).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "Coupon not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to fetch coupon: %v", err)
+	}
+
+	return coupon.ToPb(), nil
+}
+
+func (s *DiscountServiceServer) GetAllCoupons(ctx context.Context, req *pb.GetAllCouponsRequest) (*pb.GetAllCouponsResponse, error) {
+	query := s.DB.WithContext(ctx).Preload("EligibleItems").Order("id")
+	if req.GetActiveOnly() {
+		query = query.Where("active = ?", true)
+	}
+
+	var coupons []models.Coupon
+	if err := query.Find(&coupons).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to fetch coupons: %v", err)
+	}
+
+	response := &pb.GetAllCouponsResponse{}
+	for i := range coupons {
+		response.Coupons = append(response.Coupons, coupons[i].ToPb())
+	}
+	return response, nil
+}
+
+// DeleteCoupon soft deletes a coupon. Orders that already redeemed it keep their discount.
+func (s *DiscountServiceServer) DeleteCoupon(ctx context.Context, req *pb.DeleteCouponRequest) (*pb.DeleteCouponResponse, error) {
+	// Attempt to find the coupon, including soft-deleted coupons
+	var coupon models.Coupon
+	if err := s.DB.WithContext(ctx).Unscoped().Where("code = ?", models.NormalizeCouponCode(req.GetCode())).First(&coupon).Error; err != nil {
+		return nil, status.Errorf(codes.NotFound, "Coupon not found: %v", err)
+	}
+
+	// Check if the coupon is already soft-deleted
+	if coupon.DeletedAt.Valid {
+		return &pb.DeleteCouponResponse{Message: "Coupon is already deleted"}, nil
+	}
+
+	// Deactivate and soft delete the coupon so it cannot be redeemed any more
+	if err := s.DB.WithContext(ctx).Model(&coupon).Update("active", false).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to deactivate coupon: %v", err)
+	}
+	if err := s.DB.WithContext(ctx).Delete(&coupon).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete coupon: %v", err)
+	}
+
+	return &pb.DeleteCouponResponse{Message: "Coupon deleted successfully"}, nil
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+)
+
+func TestApplyDiscountRules(t *testing.T) {
+	now := time.Date(2024, 12, 10, 12, 0, 0, 0, time.UTC)
+	later := now.Add(24 * time.Hour)
+	customer, otherCustomer, mug := int32(7), int32(8), int32(2)
+	items := []models.OrderItem{
+		{ItemID: 1, Quantity: 1, Price: models.NewMoney(5000, "USD")}, // 50.00
+		{ItemID: 2, Quantity: 10, Price: models.NewMoney(333, "USD")}, // 33.30
+	} // Subtotal 83.30
+
+	percent := func(id int32, value float64, stacking models.DiscountStacking) models.DiscountRule {
+		return models.DiscountRule{ID: id, Name: "Percent", Kind: models.DiscountKindPercentage, Scope: models.DiscountScopeOrder,
+			Value: value, Stacking: stacking, Active: true}
+	}
+	fixed := func(id int32, minorUnits int64, stacking models.DiscountStacking) models.DiscountRule {
+		return models.DiscountRule{ID: id, Name: "Fixed", Kind: models.DiscountKindFixed, Scope: models.DiscountScopeOrder,
+			Amount: models.NewMoney(minorUnits, "USD"), Stacking: stacking, Active: true}
+	}
+	with := func(rule models.DiscountRule, change func(*models.DiscountRule)) models.DiscountRule {
+		change(&rule)
+		return rule
+	}
+
+	for _, test := range []struct {
+		name       string
+		rules      []models.DiscountRule
+		orderCount int64
+		want       []int64 // Amount of every applied discount, in order
+	}{
+		{"no rules", nil, 0, nil},
+		{"stackable rules add up", []models.DiscountRule{
+			percent(1, 10, models.DiscountStackingStackable), fixed(2, 500, models.DiscountStackingStackable),
+		}, 0, []int64{833, 500}},
+		{"percentages round half away from zero", []models.DiscountRule{
+			percent(1, 1.5, models.DiscountStackingStackable), // 124.95 cents
+		}, 0, []int64{125}},
+		{"exclusive rule first applies alone", []models.DiscountRule{
+			percent(1, 20, models.DiscountStackingExclusive), fixed(2, 500, models.DiscountStackingStackable),
+		}, 0, []int64{1666}},
+		{"exclusive rule after an applied rule is skipped", []models.DiscountRule{
+			fixed(1, 500, models.DiscountStackingStackable), percent(2, 20, models.DiscountStackingExclusive), fixed(3, 100, models.DiscountStackingStackable),
+		}, 0, []int64{500, 100}},
+		{"exclusive rule that does not hold does not stop", []models.DiscountRule{
+			with(percent(1, 20, models.DiscountStackingExclusive), func(r *models.DiscountRule) { r.MinOrderCount = 5 }),
+			fixed(2, 500, models.DiscountStackingStackable),
+		}, 0, []int64{500}},
+		{"total is trimmed to the subtotal from the last discounts", []models.DiscountRule{
+			fixed(1, 5000, models.DiscountStackingStackable), fixed(2, 5000, models.DiscountStackingStackable), fixed(3, 100, models.DiscountStackingStackable),
+		}, 0, []int64{5000, 3330, 0}},
+		{"line rules discount each matching line", []models.DiscountRule{
+			with(percent(1, 10, models.DiscountStackingStackable), func(r *models.DiscountRule) {
+				r.Scope = models.DiscountScopeLine
+				r.MinQuantity = 10
+			}),
+		}, 0, []int64{333}},
+		{"fixed line rules discount each unit", []models.DiscountRule{
+			with(fixed(1, 50, models.DiscountStackingStackable), func(r *models.DiscountRule) {
+				r.Scope = models.DiscountScopeLine
+				r.ItemID = &mug
+			}),
+		}, 0, []int64{500}},
+		{"order rules need their item", []models.DiscountRule{
+			with(fixed(1, 100, models.DiscountStackingStackable), func(r *models.DiscountRule) { r.ItemID = &mug }),
+			with(fixed(2, 100, models.DiscountStackingStackable), func(r *models.DiscountRule) { id := int32(99); r.ItemID = &id }),
+		}, 0, []int64{100}},
+		{"customer and history conditions", []models.DiscountRule{
+			with(fixed(1, 100, models.DiscountStackingStackable), func(r *models.DiscountRule) { r.UserID = &customer }),
+			with(fixed(2, 200, models.DiscountStackingStackable), func(r *models.DiscountRule) { r.UserID = &otherCustomer }),
+			with(fixed(3, 300, models.DiscountStackingStackable), func(r *models.DiscountRule) { r.MinOrderCount = 5 }),
+			with(fixed(4, 400, models.DiscountStackingStackable), func(r *models.DiscountRule) { r.MinOrderCount = 6 }),
+		}, 5, []int64{100, 300}},
+		{"date windows", []models.DiscountRule{
+			with(fixed(1, 100, models.DiscountStackingStackable), func(r *models.DiscountRule) { r.StartsAt = &later }),
+			with(fixed(2, 200, models.DiscountStackingStackable), func(r *models.DiscountRule) { r.RecurringStart, r.RecurringEnd = "12-03", "12-31" }),
+			with(fixed(3, 300, models.DiscountStackingStackable), func(r *models.DiscountRule) { r.RecurringStart, r.RecurringEnd = "12-20", "01-05" }),
+		}, 0, []int64{200}},
+	} {
+		discounts := applyDiscountRules(test.rules, customer, test.orderCount, now, items, "USD")
+		var got []int64
+		var total int64
+		for _, applied := range discounts.Applied {
+			got = append(got, applied.Amount)
+			total += applied.Amount
+		}
+		if len(got) != len(test.want) {
+			t.Errorf("%s: applied %v, want %v", test.name, got, test.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("%s: applied %v, want %v", test.name, got, test.want)
+				break
+			}
+		}
+		if discounts.TotalDiscountAmount != total || discounts.Currency != "USD" {
+			t.Errorf("%s: total %d %s, want %d USD", test.name, discounts.TotalDiscountAmount, discounts.Currency, total)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"log"
+	"time"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+// outboxLockKey is the transaction-level advisory lock that lets one reader at a time sequence the outbox
+const outboxLockKey = 0x6f6d735f6f7574 // "oms_out"
+
+// DefaultEventPollInterval is how often subscriptions look for new events when none is configured
+const DefaultEventPollInterval = time.Second
+
+// eventBatchSize is how many events a subscription reads per query
+const eventBatchSize = 500
+
+// recordEvent appends event to the outbox inside tx, so it commits or rolls back with the change it
+// describes. The event is sequenced by sequenceEvents once tx and every transaction older than it ended.
+func recordEvent(tx *gorm.DB, eventType models.EventType, aggregateType string, aggregateID int32, event *pb.Event) error {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		log.Println("Error encoding event:", err)
+		return status.Errorf(codes.Internal, "Failed to record event")
+	}
+	outbox := models.OutboxEvent{
+		Type:          eventType,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Payload:       payload,
+	}
+	if err := tx.Create(&outbox).Error; err != nil {
+		log.Println("Error inserting event:", err)
+		return status.Errorf(codes.Internal, "Failed to record event")
+	}
+	return nil
+}
+
+// sequenceEvents numbers the outbox events whose transactions are older than every transaction still
+// running, in transaction order. Newer events wait for a later call: an older transaction that is still
+// running may yet commit events of its own, which must come first.
+func sequenceEvents(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", outboxLockKey).Error; err != nil {
+			return err
+		}
+		return tx.Exec(`UPDATE outbox_events SET sequence = numbered.sequence
+			FROM (SELECT id, (SELECT COALESCE(MAX(sequence), 0) FROM outbox_events) + ROW_NUMBER() OVER (ORDER BY transaction_id, id) AS sequence
+				FROM outbox_events
+				WHERE sequence IS NULL AND transaction_id < pg_snapshot_xmin(pg_current_snapshot())::text::bigint) numbered
+			WHERE outbox_events.id = numbered.id`).Error
+	})
+}
+
+// recordOrderEvent records an event carrying the order as it stands in tx. previous is the status the
+// order moved from, for status changes.
+func recordOrderEvent(tx *gorm.DB, eventType models.EventType, orderID int32, previous models.OrderStatus) error {
+	var order models.Order
+	if err := tx.Unscoped().First(&order, orderID).Error; err != nil {
+		log.Println("Error fetching order:", err)
+		return status.Errorf(codes.Internal, "Failed to fetch order")
+	}
+	orders := []models.Order{order}
+	if err := loadOrderDetails(tx.Statement.Context, tx, orders); err != nil {
+		return err
+	}
+
+	event := &pb.Event{
+		Payload:        &pb.Event_Order{Order: orders[0].ToPb()},
+		PreviousStatus: previous.ToPb(),
+	}
+	return recordEvent(tx, eventType, "order", orderID, event)
+}
+
+// recordItemEvent records an event carrying the item as it stands in tx
+func recordItemEvent(tx *gorm.DB, eventType models.EventType, itemID int32) error {
+	var item models.Item
+	if err := tx.Unscoped().Preload("Prices").First(&item, itemID).Error; err != nil {
+		log.Println("Error fetching item:", err)
+		return status.Errorf(codes.Internal, "Failed to fetch item")
+	}
+	return recordEvent(tx, eventType, "item", itemID, &pb.Event{Payload: &pb.Event_Item{Item: item.ToPb()}})
+}
+
+// recordUserEvent records an event carrying the user as it stands in tx
+func recordUserEvent(tx *gorm.DB, eventType models.EventType, userID int32) error {
+	var user models.User
+	if err := tx.Unscoped().First(&user, userID).Error; err != nil {
+		log.Println("Error fetching user:", err)
+		return status.Errorf(codes.Internal, "Failed to fetch user")
+	}
+	return recordEvent(tx, eventType, "user", userID, &pb.Event{Payload: &pb.Event_User{User: user.ToPb()}})
+}
+
+// EventServiceServer implements the gRPC EventService
+type EventServiceServer struct {
+	pb.UnimplementedEventServiceServer
+	DB           *gorm.DB
+	PollInterval time.Duration // How often to look for new events; DefaultEventPollInterval when zero
+}
+
+// SubscribeEvents sends the events from the requested sequence on, then polls for new ones until the
+// subscriber goes away
+func (s *EventServiceServer) SubscribeEvents(req *pb.SubscribeEventsRequest, stream pb.EventService_SubscribeEventsServer) error {
+	ctx := stream.Context()
+	interval := s.PollInterval
+	if interval <= 0 {
+		interval = DefaultEventPollInterval
+	}
+	next := req.GetFromSequence()
+	if next < 1 {
+		next = 1
+	}
+
+	for {
+		// Sequence the events committed since the last poll, then read the next batch
+		if err := sequenceEvents(s.DB.WithContext(ctx)); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.Println("Error sequencing events:", err)
+			return status.Errorf(codes.Internal, "Failed to sequence events")
+		}
+		query := s.DB.WithContext(ctx).Where("sequence >= ?", next)
+		if len(req.GetTypes()) > 0 {
+			query = query.Where("type IN ?", req.GetTypes())
+		}
+		var events []models.OutboxEvent
+		if err := query.Order("sequence").Limit(eventBatchSize).Find(&events).Error; err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return status.Errorf(codes.Internal, "Failed to fetch events: %v", err)
+		}
+
+		// Send them in order
+		for i := range events {
+			event, err := events[i].ToPb()
+			if err != nil {
+				log.Println("Error decoding event", *events[i].Sequence, ":", err)
+				return status.Errorf(codes.Internal, "Failed to decode event %d", *events[i].Sequence)
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+			next = event.GetSequence() + 1
+		}
+
+		// Wait for new events once caught up
+		if len(events) == eventBatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
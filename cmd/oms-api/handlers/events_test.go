@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+)
+
+func TestSequenceEventsWaitsForOlderTransactions(t *testing.T) {
+	db := openTestDB(t)
+	sequences := func() map[int32]int64 {
+		t.Helper()
+		if err := sequenceEvents(db); err != nil {
+			t.Fatalf("sequenceEvents: %v", err)
+		}
+		var events []models.OutboxEvent
+		if err := db.Where("sequence IS NOT NULL").Find(&events).Error; err != nil {
+			t.Fatalf("fetch events: %v", err)
+		}
+		sequenced := map[int32]int64{}
+		for _, event := range events {
+			sequenced[event.AggregateID] = *event.Sequence
+		}
+		return sequenced
+	}
+
+	// The older transaction records its event first but commits last
+	older := db.Begin()
+	defer older.Rollback()
+	if err := recordEvent(older, models.EventItemCreated, "item", 1, &pb.Event{}); err != nil {
+		t.Fatalf("record older event: %v", err)
+	}
+	newer := db.Begin()
+	if err := recordEvent(newer, models.EventItemCreated, "item", 2, &pb.Event{}); err != nil {
+		t.Fatalf("record newer event: %v", err)
+	}
+	if err := newer.Commit().Error; err != nil {
+		t.Fatalf("commit newer: %v", err)
+	}
+	if got := sequences(); len(got) != 0 {
+		t.Fatalf("sequenced %v while an older transaction was running", got)
+	}
+
+	if err := older.Commit().Error; err != nil {
+		t.Fatalf("commit older: %v", err)
+	}
+	if got := sequences(); got[1] != 1 || got[2] != 2 {
+		t.Fatalf("sequences = %v, want item 1 first and item 2 second", got)
+	}
+}
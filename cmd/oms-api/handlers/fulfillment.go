@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FulfillmentServiceServer implements the gRPC FulfillmentService
+type FulfillmentServiceServer struct {
+	pb.UnimplementedFulfillmentServiceServer
+	DB *gorm.DB
+}
+
+// lockOrder fetches a non-deleted order inside tx and locks its row, so shipments of the same order are
+// recorded one at a time
+func lockOrder(tx *gorm.DB, orderID int32) (models.Order, error) {
+	var order models.Order
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ? AND deleted_at IS NULL", orderID).First(&order).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return order, status.Errorf(codes.NotFound, "Order not found")
+		}
+		log.Println("Error fetching order:", err)
+		return order, status.Errorf(codes.Internal, "Failed to fetch order")
+	}
+	return order, nil
+}
+
+// unshippedQuantities returns, per item, the ordered units that are not in any shipment yet
+func unshippedQuantities(tx *gorm.DB, orderID int32) (map[int32]int32, error) {
+	var lines []models.OrderItem
+	if err := tx.Where("order_id = ?", orderID).Find(&lines).Error; err != nil {
+		log.Println("Error fetching order items:", err)
+		return nil, status.Errorf(codes.Internal, "Failed to fetch order items")
+	}
+	remaining := quantitiesByItem(lines)
+
+	var shipped []models.ShipmentItem
+	if err := tx.Joins("JOIN shipments ON shipments.id = shipment_items.shipment_id").
+		Where("shipments.order_id = ?", orderID).Find(&shipped).Error; err != nil {
+		log.Println("Error fetching shipped items:", err)
+		return nil, status.Errorf(codes.Internal, "Failed to fetch shipments")
+	}
+	for _, item := range shipped {
+		remaining[item.ItemID] -= item.Quantity
+	}
+	return remaining, nil
+}
+
+// advanceOrderFulfillment moves order to the status its shipments reach: partially shipped while units are
+// left to ship, shipped once all are sent and delivered once every shipment has arrived
+func advanceOrderFulfillment(tx *gorm.DB, order *models.Order, reason string) error {
+	remaining, err := unshippedQuantities(tx, order.ID)
+	if err != nil {
+		return err
+	}
+	allShipped := true
+	for _, quantity := range remaining {
+		if quantity > 0 {
+			allShipped = false
+		}
+	}
+
+	var undelivered int64
+	if err := tx.Model(&models.Shipment{}).Where("order_id = ? AND delivered_at IS NULL", order.ID).Count(&undelivered).Error; err != nil {
+		log.Println("Error counting undelivered shipments:", err)
+		return status.Errorf(codes.Internal, "Failed to fetch shipments")
+	}
+
+	// Walk the lifecycle one step at a time so every step lands in the history
+	for {
+		var next models.OrderStatus
+		switch {
+		case !allShipped && order.Status != models.OrderStatusPartiallyShipped:
+			next = models.OrderStatusPartiallyShipped
+		case allShipped && order.Status.IsShippable():
+			next = models.OrderStatusShipped
+		case allShipped && undelivered == 0 && order.Status == models.OrderStatusShipped:
+			next = models.OrderStatusDelivered
+		default:
+			return nil
+		}
+		if err := transitionOrder(tx, order, next, reason); err != nil {
+			return err
+		}
+	}
+}
+
+// CreateShipment records a shipment of a confirmed order and advances the order status
+func (s *FulfillmentServiceServer) CreateShipment(ctx context.Context, req *pb.CreateShipmentRequest) (*pb.Shipment, error) {
+	// Validate the request
+	if strings.TrimSpace(req.GetCarrier()) == "" || strings.TrimSpace(req.GetTrackingNumber()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Carrier and tracking number are required")
+	}
+	if len(req.GetItems()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "A shipment with at least one item is required")
+	}
+	shippedAt := time.Now()
+	if req.GetShippedAt() != "" {
+		parsed, err := parseTimeFilter("shipped_at", req.GetShippedAt())
+		if err != nil {
+			return nil, err
+		}
+		shippedAt = *parsed
+	}
+
+	shipment := models.Shipment{
+		OrderID:        req.GetOrderId(),
+		Carrier:        strings.TrimSpace(req.GetCarrier()),
+		TrackingNumber: strings.TrimSpace(req.GetTrackingNumber()),
+		ShippedAt:      shippedAt,
+	}
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the order; only confirmed orders can be shipped
+		order, err := lockOrder(tx, req.GetOrderId())
+		if err != nil {
+			return err
+		}
+		if !order.Status.IsShippable() {
+			return status.Errorf(codes.FailedPrecondition, "Orders in status '%s' cannot be shipped", order.Status)
+		}
+
+		// A shipment may only carry units that have not been shipped yet
+		remaining, err := unshippedQuantities(tx, order.ID)
+		if err != nil {
+			return err
+		}
+		for _, item := range req.GetItems() {
+			if item.GetQuantity() <= 0 {
+				return status.Errorf(codes.InvalidArgument, "Quantity for item %d must be positive", item.GetItemId())
+			}
+			if item.GetQuantity() > remaining[item.GetItemId()] {
+				return status.Errorf(codes.FailedPrecondition, "Only %d unit(s) of item %d are left to ship", remaining[item.GetItemId()], item.GetItemId())
+			}
+			remaining[item.GetItemId()] -= item.GetQuantity()
+			shipment.Items = append(shipment.Items, models.ShipmentItem{ItemID: item.GetItemId(), Quantity: item.GetQuantity()})
+		}
+
+		// Insert the shipment with its items
+		if err := tx.Create(&shipment).Error; err != nil {
+			log.Println("Error inserting shipment:", err)
+			return status.Errorf(codes.Internal, "Failed to insert shipment")
+		}
+
+		return advanceOrderFulfillment(tx, &order, fmt.Sprintf("Shipment %d sent with %s (%s)", shipment.ID, shipment.Carrier, shipment.TrackingNumber))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return shipment.ToPb(), nil
+}
+
+// MarkShipmentDelivered records the delivery of a shipment and advances the order status
+func (s *FulfillmentServiceServer) MarkShipmentDelivered(ctx context.Context, req *pb.MarkShipmentDeliveredRequest) (*pb.Shipment, error) {
+	deliveredAt := time.Now()
+	if req.GetDeliveredAt() != "" {
+		parsed, err := parseTimeFilter("delivered_at", req.GetDeliveredAt())
+		if err != nil {
+			return nil, err
+		}
+		deliveredAt = *parsed
+	}
+
+	var shipment models.Shipment
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Find the shipment
+		if err := tx.Preload("Items").First(&shipment, req.GetShipmentId()).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Errorf(codes.NotFound, "Shipment not found")
+			}
+			return status.Errorf(codes.Internal, "Failed to fetch shipment: %v", err)
+		}
+
+		// Lock the order before changing any of its shipments
+		order, err := lockOrder(tx, shipment.OrderID)
+		if err != nil {
+			return err
+		}
+
+		if shipment.DeliveredAt != nil {
+			return status.Errorf(codes.FailedPrecondition, "Shipment %d was already delivered", shipment.ID)
+		}
+		if deliveredAt.Before(shipment.ShippedAt) {
+			return status.Errorf(codes.InvalidArgument, "delivered_at cannot be before the shipment was sent")
+		}
+
+		// Record the delivery
+		if err := tx.Model(&shipment).Update("delivered_at", deliveredAt).Error; err != nil {
+			log.Println("Error updating shipment:", err)
+			return status.Errorf(codes.Internal, "Failed to update shipment")
+		}
+		shipment.DeliveredAt = &deliveredAt
+
+		return advanceOrderFulfillment(tx, &order, fmt.Sprintf("Shipment %d delivered", shipment.ID))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return shipment.ToPb(), nil
+}
+
+func (s *FulfillmentServiceServer) GetShipment(ctx context.Context, req *pb.GetShipmentRequest) (*pb.Shipment, error) {
+	var shipment models.Shipment
+	if err := s.DB.WithContext(ctx).Preload("Items").First(&shipment, req.GetShipmentId()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Shipment not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to fetch shipment: %v", err)
+	}
+	return shipment.ToPb(), nil
+}
+
+func (s *FulfillmentServiceServer) GetShipmentsByOrderId(ctx context.Context, req *pb.GetShipmentsByOrderIdRequest) (*pb.GetShipmentsResponse, error) {
+	var shipments []models.Shipment
+	if err := s.DB.WithContext(ctx).Preload("Items").Where("order_id = ?", req.GetOrderId()).Order("id").Find(&shipments).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to fetch shipments: %v", err)
+	}
+
+	response := &pb.GetShipmentsResponse{}
+	for i := range shipments {
+		response.Shipments = append(response.Shipments, shipments[i].ToPb())
+	}
+	return response, nil
+}
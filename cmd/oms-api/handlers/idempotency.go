@@ -0,0 +1,255 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// idempotencyKeyHeader is the metadata key clients may use instead of the idempotency_key request field
+const idempotencyKeyHeader = "idempotency-key"
+
+// DefaultIdempotencyTTL is how long idempotency keys are kept when no TTL is configured
+const DefaultIdempotencyTTL = 24 * time.Hour
+
+// idempotencyLease is how long a request holds its key before a retry may take it over. Running requests
+// renew it, so it only runs out when the process died or lost the database.
+const idempotencyLease = time.Minute
+
+// idempotencyScope names the caller an idempotency key belongs to, so callers never replay each other's
+// responses. Unauthenticated callers share the empty scope.
+func idempotencyScope(ctx context.Context) string {
+	principal, ok := PrincipalFromContext(ctx)
+	switch {
+	case !ok:
+		return ""
+	case principal.APIKeyID != 0:
+		return fmt.Sprintf("api_key:%d", principal.APIKeyID)
+	case principal.UserID != 0:
+		return fmt.Sprintf("user:%d", principal.UserID)
+	}
+	return "cert:" + principal.CertSubject
+}
+
+// keyLease renews the lease of a running request on its idempotency key
+type keyLease struct {
+	stopped chan struct{}
+	done    chan struct{}
+	until   time.Time // End of the lease this request last wrote
+	held    bool      // Whether the key was still this request's at the last renewal
+}
+
+// holdLease renews the lease on the key found by byKey every third of idempotencyLease, for as long as the
+// key still carries the lease this request wrote last
+func holdLease(byKey func() *gorm.DB, until time.Time) *keyLease {
+	lease := &keyLease{stopped: make(chan struct{}), done: make(chan struct{}), until: until, held: true}
+	go func() {
+		defer close(lease.done)
+		ticker := time.NewTicker(idempotencyLease / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-lease.stopped:
+				return
+			case <-ticker.C:
+			}
+			next := time.Now().Add(idempotencyLease).Truncate(time.Microsecond)
+			result := byKey().Where("locked_until = ?", lease.until).Update("locked_until", next)
+			if result.Error != nil {
+				log.Println("Error renewing idempotency key lease:", result.Error)
+				continue
+			}
+			if result.RowsAffected == 0 {
+				lease.held = false
+				return
+			}
+			lease.until = next
+		}
+	}()
+	return lease
+}
+
+// stop ends the renewals and returns the lease this request holds, if the key is still its
+func (l *keyLease) stop() (time.Time, bool) {
+	close(l.stopped)
+	<-l.done
+	return l.until, l.held
+}
+
+// idempotencyKeyFrom returns the key from the request field, falling back to gRPC metadata
+func idempotencyKeyFrom(ctx context.Context, fieldValue string) string {
+	if fieldValue != "" {
+		return fieldValue
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(idempotencyKeyHeader); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// requestFingerprint hashes the method and the request payload. The idempotency_key field itself is
+// left out so a key sent in the body and the same key sent as metadata fingerprint the same.
+func requestFingerprint(method string, req proto.Message) (string, error) {
+	return messageFingerprint(method, req, "idempotency_key")
+}
+
+// messageFingerprint hashes scope and the deterministic encoding of msg with the named fields cleared
+func messageFingerprint(scope string, msg proto.Message, ignoredFields ...string) (string, error) {
+	clone := proto.Clone(msg)
+	fields := clone.ProtoReflect().Descriptor().Fields()
+	for _, name := range ignoredFields {
+		if field := fields.ByName(protoreflect.Name(name)); field != nil {
+			clone.ProtoReflect().Clear(field)
+		}
+	}
+
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(clone)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(scope))
+	hash.Write([]byte{0})
+	hash.Write(payload)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// idempotent runs handler at most once per idempotency key of the caller and method. A replay with the
+// same key and payload returns the stored response, a replay with a different payload fails with
+// AlreadyExists, and a replay while the first request is still running fails with Aborted. A replay after
+// the lease of a request that never stored its response runs handler again. Requests without a key run
+// handler directly.
+func idempotent[T proto.Message](ctx context.Context, db *gorm.DB, ttl time.Duration, method string, key string, req proto.Message, handler func() (T, error)) (T, error) {
+	var zero T
+	if key == "" {
+		return handler()
+	}
+	if ttl <= 0 {
+		ttl = DefaultIdempotencyTTL
+	}
+
+	fingerprint, err := requestFingerprint(method, req)
+	if err != nil {
+		log.Println("Error fingerprinting request:", err)
+		return zero, status.Errorf(codes.Internal, "Failed to process idempotency key")
+	}
+
+	// Keys belong to the caller and the method they were used with
+	scope := idempotencyScope(ctx)
+	byKey := func() *gorm.DB {
+		return db.Model(&models.IdempotencyKey{}).Where("principal = ? AND method = ? AND key = ?", scope, method, key)
+	}
+
+	// Expired keys are free to be used again
+	now := time.Now()
+	if err := byKey().Where("expires_at < ?", now).Delete(&models.IdempotencyKey{}).Error; err != nil {
+		log.Println("Error removing expired idempotency key:", err)
+		return zero, status.Errorf(codes.Internal, "Failed to process idempotency key")
+	}
+
+	// Claim the key; only one request can insert the row
+	lockedUntil := now.Add(idempotencyLease).Truncate(time.Microsecond)
+	claim := models.IdempotencyKey{
+		Principal:   scope,
+		Method:      method,
+		Key:         key,
+		Fingerprint: fingerprint,
+		LockedUntil: &lockedUntil,
+		ExpiresAt:   now.Add(ttl),
+	}
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&claim)
+	if result.Error != nil {
+		log.Println("Error claiming idempotency key:", result.Error)
+		return zero, status.Errorf(codes.Internal, "Failed to process idempotency key")
+	}
+
+	// Someone already used the key: replay or reject
+	if result.RowsAffected == 0 {
+		var existing models.IdempotencyKey
+		if err := byKey().First(&existing).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return zero, status.Errorf(codes.Aborted, "Idempotency key expired while being replayed, retry the request")
+			}
+			log.Println("Error fetching idempotency key:", err)
+			return zero, status.Errorf(codes.Internal, "Failed to process idempotency key")
+		}
+		if existing.Fingerprint != fingerprint {
+			return zero, status.Errorf(codes.AlreadyExists, "Idempotency key was already used with a different request")
+		}
+		if len(existing.Response) > 0 {
+			response := zero.ProtoReflect().New().Interface().(T)
+			if err := proto.Unmarshal(existing.Response, response); err != nil {
+				log.Println("Error decoding stored response:", err)
+				return zero, status.Errorf(codes.Internal, "Failed to replay stored response")
+			}
+			return response, nil
+		}
+
+		// The first request has no response yet: wait for it, or take the key over once its lease ran out
+		if existing.LockedUntil != nil && now.Before(*existing.LockedUntil) {
+			return zero, status.Errorf(codes.Aborted, "A request with this idempotency key is still in progress")
+		}
+		takeover := byKey().Where("response IS NULL AND (locked_until IS NULL OR locked_until <= ?)", now).
+			Update("locked_until", lockedUntil)
+		if takeover.Error != nil {
+			log.Println("Error taking over idempotency key:", takeover.Error)
+			return zero, status.Errorf(codes.Internal, "Failed to process idempotency key")
+		}
+		if takeover.RowsAffected == 0 {
+			return zero, status.Errorf(codes.Aborted, "A request with this idempotency key is still in progress")
+		}
+	}
+
+	// The key is ours: run the request, renewing the lease until it returns
+	lease := holdLease(byKey, lockedUntil)
+	response, err := handler()
+	lockedUntil, held := lease.stop()
+	if !held {
+		log.Println("Idempotency key was taken over while its request ran; the outcome is not recorded")
+		if err != nil {
+			return zero, err
+		}
+		return response, nil
+	}
+	if err != nil {
+		// Failed requests are not stored so the client can retry with the same key right away
+		if delErr := byKey().Where("response IS NULL AND locked_until = ?", lockedUntil).Delete(&models.IdempotencyKey{}).Error; delErr != nil {
+			log.Println("Error releasing idempotency key:", delErr)
+		}
+		return zero, err
+	}
+
+	// A response that cannot be stored leaves the key to whichever retry comes after the lease
+	encoded, err := proto.Marshal(response)
+	if err == nil {
+		err = byKey().Where("locked_until = ?", lockedUntil).
+			Updates(map[string]interface{}{"response": encoded, "locked_until": nil}).Error
+	}
+	if err != nil {
+		log.Println("Error storing response for idempotency key:", err)
+	}
+
+	return response, nil
+}
+
+// PurgeExpiredIdempotencyKeys deletes idempotency keys whose TTL has passed
+func PurgeExpiredIdempotencyKeys(db *gorm.DB) (int64, error) {
+	result := db.Where("expires_at < ?", time.Now()).Delete(&models.IdempotencyKey{})
+	return result.RowsAffected, result.Error
+}
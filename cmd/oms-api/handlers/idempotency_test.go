@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+)
+
+func TestIdempotencyScope(t *testing.T) {
+	for _, test := range []struct {
+		principal *Principal
+		want      string
+	}{
+		{&Principal{UserID: 7, Role: models.RoleCustomer}, "user:7"},
+		{&Principal{APIKeyID: 3, Scopes: []models.Permission{models.PermOrdersWrite}}, "api_key:3"},
+		{&Principal{Role: models.RoleService, CertSubject: "warehouse"}, "cert:warehouse"},
+	} {
+		if got := idempotencyScope(ContextWithPrincipal(context.Background(), test.principal)); got != test.want {
+			t.Errorf("idempotencyScope(%v) = %q, want %q", test.principal, got, test.want)
+		}
+	}
+	if got := idempotencyScope(context.Background()); got != "" {
+		t.Errorf("idempotencyScope without a caller = %q, want empty", got)
+	}
+}
+
+func TestIdempotentKeysBelongToCallerAndMethod(t *testing.T) {
+	db := openTestDB(t)
+	alice := ContextWithPrincipal(context.Background(), &Principal{UserID: 1, Role: models.RoleCustomer})
+	bob := ContextWithPrincipal(context.Background(), &Principal{UserID: 2, Role: models.RoleCustomer})
+	req := &pb.GetOrderRequest{OrderId: 1}
+
+	runs := 0
+	handler := func() (*pb.DeleteOrderResponse, error) {
+		runs++
+		return &pb.DeleteOrderResponse{Message: "run"}, nil
+	}
+	call := func(ctx context.Context, method string) {
+		t.Helper()
+		if _, err := idempotent(ctx, db, 0, method, "key", req, handler); err != nil {
+			t.Fatalf("idempotent(%s): %v", method, err)
+		}
+	}
+
+	// The same key replays for its caller and method, and runs anew for another caller or method
+	call(alice, "/Test/A")
+	call(alice, "/Test/A")
+	call(bob, "/Test/A")
+	call(alice, "/Test/B")
+	if runs != 3 {
+		t.Errorf("handler ran %d times, want 3", runs)
+	}
+
+	// A failed request releases its key for a retry
+	failure := errors.New("failed")
+	if _, err := idempotent(alice, db, 0, "/Test/C", "key", req, func() (*pb.DeleteOrderResponse, error) { return nil, failure }); !errors.Is(err, failure) {
+		t.Fatalf("idempotent = %v, want %v", err, failure)
+	}
+	call(alice, "/Test/C")
+	if runs != 4 {
+		t.Errorf("handler ran %d times after the failed request, want 4", runs)
+	}
+}
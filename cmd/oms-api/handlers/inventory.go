@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// stockChange describes how a set of order lines moves the stock columns of their items
+type stockChange struct {
+	onHandSign   int32
+	reservedSign int32
+	reason       string
+}
+
+var (
+	// reserveChange holds units for an order that is not confirmed yet
+	reserveChange = stockChange{onHandSign: 0, reservedSign: 1, reason: "Reserved for order"}
+	// releaseChange gives reserved units back when an unconfirmed order is cancelled, edited or moved back to draft
+	releaseChange = stockChange{onHandSign: 0, reservedSign: -1, reason: "Reservation released"}
+	// commitChange turns a reservation into a deduction when the order is confirmed
+	commitChange = stockChange{onHandSign: -1, reservedSign: -1, reason: "Reservation committed"}
+	// restockChange puts deducted units back on hand
+	restockChange = stockChange{onHandSign: 1, reservedSign: 0, reason: "Returned to stock"}
+)
+
+// quantitiesByItem sums the quantity of every order line per item
+func quantitiesByItem(lines []models.OrderItem) map[int32]int32 {
+	quantities := make(map[int32]int32)
+	for _, line := range lines {
+		quantities[line.ItemID] += line.Quantity
+	}
+	return quantities
+}
+
+// lockItems loads the items with FOR UPDATE row locks. Rows are locked in id order so two orders
+// touching the same items cannot deadlock each other.
+func lockItems(tx *gorm.DB, itemIDs []int32, includeDeleted bool) (map[int32]*models.Item, error) {
+	sort.Slice(itemIDs, func(i, j int) bool { return itemIDs[i] < itemIDs[j] })
+
+	query := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id IN ?", itemIDs).Order("id")
+	if includeDeleted {
+		query = query.Unscoped()
+	}
+
+	var items []models.Item
+	if err := query.Find(&items).Error; err != nil {
+		log.Println("Error locking items:", err)
+		return nil, status.Errorf(codes.Internal, "Failed to lock items")
+	}
+
+	locked := make(map[int32]*models.Item, len(items))
+	for i := range items {
+		locked[items[i].ID] = &items[i]
+	}
+	return locked, nil
+}
+
+// applyStockChange moves the stock of every item in lines according to change. It must run inside a
+// transaction; the affected item rows stay locked until that transaction ends. Every line must have a
+// positive quantity, as a negative one would move stock the wrong way.
+func applyStockChange(tx *gorm.DB, orderID *int32, lines []models.OrderItem, change stockChange) error {
+	for _, line := range lines {
+		if line.Quantity <= 0 {
+			return status.Errorf(codes.InvalidArgument, "Quantity for item %d must be positive", line.ItemID)
+		}
+	}
+
+	quantities := quantitiesByItem(lines)
+	if len(quantities) == 0 {
+		return nil
+	}
+
+	itemIDs := make([]int32, 0, len(quantities))
+	for itemID := range quantities {
+		itemIDs = append(itemIDs, itemID)
+	}
+
+	// Only new reservations need the item to still be for sale
+	locked, err := lockItems(tx, itemIDs, change != reserveChange)
+	if err != nil {
+		return err
+	}
+
+	for _, itemID := range itemIDs {
+		item, found := locked[itemID]
+		if !found {
+			return status.Errorf(codes.InvalidArgument, "Invalid item ID: %d", itemID)
+		}
+
+		quantity := quantities[itemID]
+		if change == reserveChange && item.StockAvailable() < quantity {
+			return status.Errorf(codes.ResourceExhausted, "Insufficient stock for item %d: requested %d, available %d", itemID, quantity, item.StockAvailable())
+		}
+
+		onHandDelta := change.onHandSign * quantity
+		reservedDelta := change.reservedSign * quantity
+
+		// Update the stock columns of the locked row
+		if err := tx.Model(&models.Item{}).Unscoped().Where("id = ?", itemID).Updates(map[string]interface{}{
+			"stock_on_hand":  gorm.Expr("stock_on_hand + ?", onHandDelta),
+			"stock_reserved": gorm.Expr("stock_reserved + ?", reservedDelta),
+		}).Error; err != nil {
+			log.Println("Error updating stock for item", itemID, ":", err)
+			return status.Errorf(codes.Internal, "Failed to update stock")
+		}
+
+		// Record the movement
+		reason := change.reason
+		if orderID != nil {
+			reason = fmt.Sprintf("%s %d", change.reason, *orderID)
+		}
+		movement := models.StockMovement{
+			ItemID:        itemID,
+			OrderID:       orderID,
+			OnHandDelta:   onHandDelta,
+			ReservedDelta: reservedDelta,
+			Reason:        reason,
+		}
+		if err := tx.Create(&movement).Error; err != nil {
+			log.Println("Error inserting stock movement:", err)
+			return status.Errorf(codes.Internal, "Failed to record stock movement")
+		}
+	}
+
+	return nil
+}
+
+// orderStockChange returns how the stock of an order's lines moves when the order goes from status from to
+// target, and false when it does not move
+func orderStockChange(from models.OrderStatus, target models.OrderStatus) (stockChange, bool) {
+	switch {
+	case from == models.OrderStatusDraft && target == models.OrderStatusPending:
+		return reserveChange, true
+	case from == models.OrderStatusPending && target == models.OrderStatusConfirmed:
+		return commitChange, true
+	case from == models.OrderStatusPending && (target == models.OrderStatusCancelled || target == models.OrderStatusDraft):
+		return releaseChange, true
+	case (from == models.OrderStatusConfirmed || from == models.OrderStatusPacked) && target == models.OrderStatusCancelled:
+		return restockChange, true
+	}
+	return stockChange{}, false
+}
+
+// applyOrderStockTransition applies the stock effect of moving order from its current status to target
+func applyOrderStockTransition(tx *gorm.DB, order *models.Order, target models.OrderStatus) error {
+	change, moves := orderStockChange(order.Status, target)
+	if !moves {
+		return nil
+	}
+
+	// Load the order lines whose stock moves
+	var lines []models.OrderItem
+	if err := tx.Where("order_id = ?", order.ID).Find(&lines).Error; err != nil {
+		log.Println("Error fetching order items:", err)
+		return status.Errorf(codes.Internal, "Failed to fetch order items")
+	}
+
+	return applyStockChange(tx, &order.ID, lines, change)
+}
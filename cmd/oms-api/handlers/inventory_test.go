@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+)
+
+func TestOrderStockChange(t *testing.T) {
+	for _, test := range []struct {
+		from, target models.OrderStatus
+		want         stockChange
+		wantMoves    bool
+	}{
+		{models.OrderStatusDraft, models.OrderStatusPending, reserveChange, true},
+		{models.OrderStatusPending, models.OrderStatusConfirmed, commitChange, true},
+		{models.OrderStatusPending, models.OrderStatusCancelled, releaseChange, true},
+		{models.OrderStatusPending, models.OrderStatusDraft, releaseChange, true},
+		{models.OrderStatusConfirmed, models.OrderStatusCancelled, restockChange, true},
+		{models.OrderStatusPacked, models.OrderStatusCancelled, restockChange, true},
+		{models.OrderStatusDraft, models.OrderStatusCancelled, stockChange{}, false},
+		{models.OrderStatusConfirmed, models.OrderStatusPacked, stockChange{}, false},
+		{models.OrderStatusShipped, models.OrderStatusDelivered, stockChange{}, false},
+	} {
+		got, moves := orderStockChange(test.from, test.target)
+		if got != test.want || moves != test.wantMoves {
+			t.Errorf("%s -> %s: got %+v, %v, want %+v, %v", test.from, test.target, got, moves, test.want, test.wantMoves)
+		}
+	}
+}
+
+func TestStockChangesBalance(t *testing.T) {
+	// A reservation is either committed or released, and a committed unit comes back on restock
+	for _, path := range [][]stockChange{{reserveChange, releaseChange}, {reserveChange, commitChange, restockChange}} {
+		var onHand, reserved int32
+		for _, change := range path {
+			onHand += change.onHandSign
+			reserved += change.reservedSign
+		}
+		if onHand != 0 || reserved != 0 {
+			t.Errorf("%+v leaves %d on hand and %d reserved, want 0 and 0", path, onHand, reserved)
+		}
+	}
+}
+
+func TestQuantitiesByItem(t *testing.T) {
+	got := quantitiesByItem([]models.OrderItem{{ItemID: 1, Quantity: 2}, {ItemID: 2, Quantity: 1}, {ItemID: 1, Quantity: 3}})
+	if want := map[int32]int32{1: 5, 2: 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("quantitiesByItem = %v, want %v", got, want)
+	}
+}
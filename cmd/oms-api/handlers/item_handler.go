@@ -10,30 +10,62 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // OmsServiceServer implements the gRPC server
 type OmsItemServiceServer struct {
 	pb.UnimplementedOmsItemServiceServer
-	DB *gorm.DB
+	DB         *gorm.DB
+	PageTokens *PageTokenCodec
+}
+
+// itemPriceFromRequest resolves the price of an item request. unitPrice wins; otherwise the deprecated
+// whole-unit price is taken in currency.
+func itemPriceFromRequest(unitPrice *pb.Money, legacyPrice int32, currency string) (models.Money, error) {
+	if unitPrice == nil {
+		return models.MoneyFromMajor(float64(legacyPrice), currency), nil
+	}
+	price, err := models.MoneyFromPb(unitPrice)
+	if err != nil {
+		return models.Money{}, status.Errorf(codes.InvalidArgument, "Invalid unit price: %v", err)
+	}
+	return price, nil
 }
 
 func (s *OmsItemServiceServer) CreateItem(ctx context.Context, req *pb.ItemRequest) (*pb.ItemResponse, error) {
+	// Resolve the price from the request
+	price, err := itemPriceFromRequest(req.GetUnitPrice(), req.GetPrice(), models.DefaultCurrency)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate the fields (check for empty strings or invalid price)
-	if req.Name == "" || req.Description == "" || req.Price <= 0 {
+	if req.Name == "" || req.Description == "" || price.MinorUnits <= 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "All fields must be filled and price must be positive")
 	}
+	if req.StockOnHand < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Stock on hand cannot be negative")
+	}
 
 	// Create a new Item model instance from the request
 	newItem := models.Item{
 		Name:        req.Name,
 		Description: req.Description,
-		Price:       req.Price,
+		Price:       price,
+		StockOnHand: req.StockOnHand,
+		TaxCategory: models.NormalizeTaxCategory(req.GetTaxCategory()),
 	}
 
-	// Use GORM to insert the new item into the database
-	if err := s.DB.Create(&newItem).Error; err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to insert item: %v", err)
+	// Use GORM to insert the new item into the database, together with its event
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&newItem).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to insert item: %v", err)
+		}
+		return recordItemEvent(tx, models.EventItemCreated, newItem.ID)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Return the response with the new item details
@@ -48,7 +80,7 @@ func (s *OmsItemServiceServer) GetItemById(ctx context.Context, req *pb.GetItemR
 	var item models.Item
 
 	// Fetch the item by ID using GORM
-	if err := s.DB.Where("id = ? AND deleted_at IS NULL", req.Id).First(&item).Error; err != nil {
+	if err := s.DB.Preload("Prices").Where("id = ? AND deleted_at IS NULL", req.Id).First(&item).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, status.Errorf(codes.NotFound, "Item not found")
 		}
@@ -59,12 +91,56 @@ func (s *OmsItemServiceServer) GetItemById(ctx context.Context, req *pb.GetItemR
 	return item.ToPb(), nil
 }
 
-func (s *OmsItemServiceServer) GetAllItems(ctx context.Context, req *pb.EmptyRequest) (*pb.GetAllItemResponse, error) {
-	var items []models.Item
+// listItems fetches the page of items matching req that starts at pageToken
+func (s *OmsItemServiceServer) listItems(ctx context.Context, req *pb.GetAllItemsRequest, pageToken string) ([]models.Item, string, error) {
+	// Build the filtered query over non-deleted items
+	query := s.DB.WithContext(ctx).Model(&models.Item{}).Preload("Prices")
+	if req.GetNameContains() != "" {
+		query = query.Where("name ILIKE ?", likePattern(req.GetNameContains()))
+	}
+	minPrice, err := priceBound(req.GetMinUnitPrice(), req.MinPrice)
+	if err != nil {
+		return nil, "", err
+	}
+	if minPrice != nil {
+		query = query.Where("price_currency = ? AND price_minor_units >= ?", minPrice.Currency, minPrice.MinorUnits)
+	}
+	maxPrice, err := priceBound(req.GetMaxUnitPrice(), req.MaxPrice)
+	if err != nil {
+		return nil, "", err
+	}
+	if maxPrice != nil {
+		query = query.Where("price_currency = ? AND price_minor_units <= ?", maxPrice.Currency, maxPrice.MinorUnits)
+	}
+
+	page := pageRequest{PageSize: req.GetPageSize(), PageToken: pageToken, OrderBy: req.GetOrderBy()}
+	return listPage(query, s.PageTokens, req, page, func(item *models.Item) (time.Time, int32) {
+		return item.CreatedAt, item.ID
+	})
+}
+
+// priceBound resolves an optional price filter; bound wins over the deprecated whole-unit legacy bound in
+// the default currency
+func priceBound(bound *pb.Money, legacy *int32) (*models.Money, error) {
+	switch {
+	case bound != nil:
+		price, err := models.MoneyFromPb(bound)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid price filter: %v", err)
+		}
+		return &price, nil
+	case legacy != nil:
+		price := models.MoneyFromMajor(float64(*legacy), models.DefaultCurrency)
+		return &price, nil
+	}
+	return nil, nil
+}
 
-	// Fetch all non-deleted items from the database
-	if err := s.DB.Where("deleted_at IS NULL").Find(&items).Error; err != nil {
-		return nil, status.Errorf(codes.Internal, "Unable to fetch data: %v", err)
+func (s *OmsItemServiceServer) GetAllItems(ctx context.Context, req *pb.GetAllItemsRequest) (*pb.GetAllItemResponse, error) {
+	// Fetch one page of items
+	items, nextPageToken, err := s.listItems(ctx, req, req.GetPageToken())
+	if err != nil {
+		return nil, err
 	}
 
 	// Convert the list of items to gRPC responses
@@ -74,25 +150,60 @@ func (s *OmsItemServiceServer) GetAllItems(ctx context.Context, req *pb.EmptyReq
 	}
 
 	// Return the response with the list of items
-	return &pb.GetAllItemResponse{Items: itemResponses}, nil
+	return &pb.GetAllItemResponse{Items: itemResponses, NextPageToken: nextPageToken}, nil
+}
+
+// StreamItems sends every item matching the request filters, reading them from the database in batches
+func (s *OmsItemServiceServer) StreamItems(req *pb.GetAllItemsRequest, stream pb.OmsItemService_StreamItemsServer) error {
+	ctx := stream.Context()
+	return streamPages(ctx, req.GetPageToken(), func(pageToken string) (string, error) {
+		items, nextPageToken, err := s.listItems(ctx, req, pageToken)
+		if err != nil {
+			return "", err
+		}
+		for _, item := range items {
+			if err := stream.Send(item.ToPb()); err != nil {
+				return "", err
+			}
+		}
+		return nextPageToken, nil
+	})
 }
 
 func (s *OmsItemServiceServer) UpdateItemById(ctx context.Context, req *pb.UpdateItemRequest) (*pb.ItemResponse, error) {
 	// Find the item by ID from the database
 	var item models.Item
-	if err := s.DB.First(&item, req.GetId()).Error; err != nil {
+	if err := s.DB.Preload("Prices").First(&item, req.GetId()).Error; err != nil {
 		return nil, status.Errorf(codes.NotFound, "Item not found: %v", err)
 	}
 
-	// Update the item's fields based on the request
-	item.Name = req.GetName()
-	item.Description = req.GetDescription()
-	item.Price = req.GetPrice()
-	item.UpdatedAt = time.Now() // Ensure UpdatedAt is set to the current time
+	// Resolve the new price; the deprecated whole-unit price keeps the item's currency
+	currency := item.Price.Currency
+	if currency == "" {
+		currency = models.DefaultCurrency
+	}
+	price, err := itemPriceFromRequest(req.GetUnitPrice(), req.GetPrice(), currency)
+	if err != nil {
+		return nil, err
+	}
 
-	// Save the updated item back to the database
-	if err := s.DB.Save(&item).Error; err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to update item: %v", err)
+	// Update the item's fields based on the request. Stock columns are left alone: they change
+	// concurrently through reservations and are only adjusted through AdjustStock.
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&item).Updates(map[string]interface{}{
+			"name":              req.GetName(),
+			"description":       req.GetDescription(),
+			"price_minor_units": price.MinorUnits,
+			"price_currency":    price.Currency,
+			"tax_category":      models.NormalizeTaxCategory(req.GetTaxCategory()),
+			"updated_at":        time.Now(), // Ensure UpdatedAt is set to the current time
+		}).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to update item: %v", err)
+		}
+		return recordItemEvent(tx, models.EventItemUpdated, item.ID)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Convert the updated item to a protobuf response
@@ -116,11 +227,153 @@ func (s *OmsItemServiceServer) DeleteItemById(ctx context.Context, req *pb.Delet
 	}
 
 	// Proceed with soft delete (setting deleted_at to the current time)
-	item.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
-	if err := s.DB.Save(&item).Error; err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to delete item: %v", err)
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&item).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to delete item: %v", err)
+		}
+		return recordItemEvent(tx, models.EventItemDeleted, item.ID)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Return the success message in the response
 	return &pb.DeleteItemResponse{Message: "Item deleted successfully"}, nil
 }
+
+// SetItemPrice sets the explicit price of an item in a currency other than its base price's. Orders in that
+// currency use it instead of converting the base price.
+func (s *OmsItemServiceServer) SetItemPrice(ctx context.Context, req *pb.SetItemPriceRequest) (*pb.ItemResponse, error) {
+	// Validate the price
+	if req.GetPrice() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Price is required")
+	}
+	price, err := models.MoneyFromPb(req.GetPrice())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid price: %v", err)
+	}
+	if price.MinorUnits <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Price must be positive")
+	}
+
+	// Find the item
+	var item models.Item
+	if err := s.DB.Where("id = ? AND deleted_at IS NULL", req.GetItemId()).First(&item).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "Item not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to fetch item: %v", err)
+	}
+	if price.Currency == item.Price.Currency {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is the base currency of the item; update its unit price instead", price.Currency)
+	}
+
+	// Create the price or replace the existing one
+	itemPrice := models.ItemPrice{ItemID: item.ID, Currency: price.Currency, MinorUnits: price.MinorUnits}
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "item_id"}, {Name: "currency"}},
+			DoUpdates: clause.AssignmentColumns([]string{"minor_units", "updated_at"}),
+		}).Create(&itemPrice).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to save item price: %v", err)
+		}
+		return recordItemEvent(tx, models.EventItemUpdated, item.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the item with all its prices
+	if err := s.DB.Preload("Prices").First(&item, item.ID).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch item: %v", err)
+	}
+	return item.ToPb(), nil
+}
+
+// DeleteItemPrice removes the explicit price of an item in a currency; orders in that currency convert the
+// base price again
+func (s *OmsItemServiceServer) DeleteItemPrice(ctx context.Context, req *pb.DeleteItemPriceRequest) (*pb.ItemResponse, error) {
+	currency, err := models.NormalizeCurrency(req.GetCurrencyCode())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid currency: %v", err)
+	}
+
+	// Delete the price
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("item_id = ? AND currency = ?", req.GetItemId(), currency).Delete(&models.ItemPrice{})
+		if result.Error != nil {
+			return status.Errorf(codes.Internal, "Failed to delete item price: %v", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return status.Errorf(codes.NotFound, "Item has no price in %s", currency)
+		}
+		return recordItemEvent(tx, models.EventItemUpdated, req.GetItemId())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the item with its remaining prices
+	var item models.Item
+	if err := s.DB.Preload("Prices").First(&item, req.GetItemId()).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch item: %v", err)
+	}
+	return item.ToPb(), nil
+}
+
+// AdjustStock changes the on-hand quantity of an item, e.g. after a delivery from a supplier or a stock count
+func (s *OmsItemServiceServer) AdjustStock(ctx context.Context, req *pb.AdjustStockRequest) (*pb.StockLevel, error) {
+	if req.GetDelta() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Delta must not be zero")
+	}
+
+	var item models.Item
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		// Lock the item row so concurrent reservations see the adjusted stock
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", req.GetItemId()).First(&item).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return status.Errorf(codes.NotFound, "Item not found")
+			}
+			return status.Errorf(codes.Internal, "Failed to fetch item: %v", err)
+		}
+
+		// Stock that is reserved for orders cannot be removed
+		onHand := item.StockOnHand + req.GetDelta()
+		if onHand < item.StockReserved {
+			return status.Errorf(codes.FailedPrecondition, "Stock on hand cannot drop below the reserved quantity (%d)", item.StockReserved)
+		}
+
+		if err := tx.Model(&item).Update("stock_on_hand", onHand).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to update stock: %v", err)
+		}
+
+		// Record the movement
+		movement := models.StockMovement{
+			ItemID:      item.ID,
+			OnHandDelta: req.GetDelta(),
+			Reason:      req.GetReason(),
+		}
+		if err := tx.Create(&movement).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to record stock movement: %v", err)
+		}
+		return recordItemEvent(tx, models.EventItemUpdated, item.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return item.ToStockPb(), nil
+}
+
+// GetStock returns the on-hand, reserved and available quantities of an item
+func (s *OmsItemServiceServer) GetStock(ctx context.Context, req *pb.GetStockRequest) (*pb.StockLevel, error) {
+	var item models.Item
+	if err := s.DB.Where("id = ? AND deleted_at IS NULL", req.GetItemId()).First(&item).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "Item not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to fetch item: %v", err)
+	}
+
+	return item.ToStockPb(), nil
+}
@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// testModels are the tables the handlers use, as migrated by the server
+var testModels = []interface{}{&models.Item{}, &models.User{}, &models.Order{}, &models.OrderItem{}, &models.UserOrder{}, &models.OrderStatusHistory{}, &models.StockMovement{}, &models.IdempotencyKey{}, &models.DiscountRule{}, &models.Coupon{}, &models.CouponItem{}, &models.CouponRedemption{}, &models.OrderAdjustment{}, &models.ItemPrice{}, &models.ExchangeRate{}, &models.OrderExchangeRate{}, &models.TaxJurisdiction{}, &models.TaxRate{}, &models.Address{}, &models.OrderAddress{}, &models.Shipment{}, &models.ShipmentItem{}, &models.Return{}, &models.ReturnLine{}, &models.Payment{}, &models.PaymentAttempt{}, &models.CheckoutSaga{}, &models.CheckoutStep{}, &models.OutboxEvent{}, &models.WebhookSubscription{}, &models.WebhookDelivery{}, &models.WebhookCursor{}, &models.Session{}, &models.APIKey{}}
+
+// openTestDB connects to the Postgres database in OMS_TEST_DATABASE_DSN and migrates a schema of its own,
+// dropped when the test ends. Tests needing a database are skipped without one.
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := os.Getenv("OMS_TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("OMS_TEST_DATABASE_DSN is not set")
+	}
+	config := &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)}
+
+	admin, err := gorm.Open(postgres.Open(dsn), config)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	schema := fmt.Sprintf("oms_test_%d", time.Now().UnixNano())
+	if err := admin.Exec("CREATE SCHEMA " + schema).Error; err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		admin.Exec("DROP SCHEMA " + schema + " CASCADE")
+		if sqlDB, err := admin.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+
+	// Every connection of the pool works in the schema
+	separator := " "
+	if strings.Contains(dsn, "://") {
+		separator = "?"
+		if strings.Contains(dsn, "?") {
+			separator = "&"
+		}
+	}
+	db, err := gorm.Open(postgres.Open(dsn+separator+"search_path="+schema), config)
+	if err != nil {
+		t.Fatalf("connect to schema: %v", err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	if err := db.AutoMigrate(testModels...); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return db
+}
+
+func TestOrderFlowRefundsReturn(t *testing.T) {
+	db := openTestDB(t)
+	payments := &PaymentServiceServer{DB: db, Provider: &FakePaymentProvider{Outcome: FakePaymentSucceed}}
+	orders := &OrderServiceServer{DB: db, Payments: payments}
+	fulfillment := &FulfillmentServiceServer{DB: db}
+	returns := &ReturnServiceServer{DB: db, Payments: payments}
+
+	customer := models.User{Name: "Customer", Email: "customer@example.com"}
+	item := models.Item{Name: "Mug", Price: models.NewMoney(1250, models.DefaultCurrency), StockOnHand: 10}
+	for _, record := range []interface{}{&customer, &item} {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+	}
+	ctx := ContextWithPrincipal(context.Background(), &Principal{Role: models.RoleAdmin})
+
+	// Place and check out an order for two mugs
+	created, err := orders.CreateOrder(ctx, &pb.CreateOrderRequest{Order: &pb.Order{
+		UserId: customer.ID,
+		Items:  []*pb.OrderItem{{ItemId: item.ID, Quantity: 2}},
+	}})
+	if err != nil {
+		t.Fatalf("CreateOrder: %v", err)
+	}
+	orderID := created.GetOrderResponse().GetId()
+	checkout, err := orders.Checkout(ctx, &pb.CheckoutRequest{OrderId: orderID, PaymentMethod: "tok_visa"})
+	if err != nil {
+		t.Fatalf("Checkout: %v", err)
+	}
+	if checkout.GetCurrentStatus() != pb.OrderStatus_ORDER_STATUS_CONFIRMED {
+		t.Fatalf("order is %v after checkout, want confirmed", checkout.GetCurrentStatus())
+	}
+	paid, err := payments.GetPaymentsByOrderId(ctx, &pb.GetPaymentsByOrderIdRequest{OrderId: orderID})
+	if err != nil {
+		t.Fatalf("GetPaymentsByOrderId: %v", err)
+	}
+	if len(paid.GetPayments()) != 1 || paid.GetPayments()[0].GetStatus() != pb.PaymentStatus_PAYMENT_STATUS_CAPTURED {
+		t.Fatalf("payments after checkout = %v, want one captured", paid.GetPayments())
+	}
+	paymentID := paid.GetPayments()[0].GetId()
+
+	// Ship and deliver both mugs
+	shipment, err := fulfillment.CreateShipment(ctx, &pb.CreateShipmentRequest{
+		OrderId: orderID,
+		Carrier: "UPS",
+		Items:   []*pb.ShipmentItem{{ItemId: item.ID, Quantity: 2}},
+	})
+	if err != nil {
+		t.Fatalf("CreateShipment: %v", err)
+	}
+	if _, err := fulfillment.MarkShipmentDelivered(ctx, &pb.MarkShipmentDeliveredRequest{ShipmentId: shipment.GetId()}); err != nil {
+		t.Fatalf("MarkShipmentDelivered: %v", err)
+	}
+
+	// Return one mug and refund it
+	requested, err := returns.RequestReturn(ctx, &pb.RequestReturnRequest{
+		OrderId: orderID,
+		Lines:   []*pb.ReturnLine{{ItemId: item.ID, Quantity: 1, Reason: "damaged"}},
+	})
+	if err != nil {
+		t.Fatalf("RequestReturn: %v", err)
+	}
+	returnID := requested.GetId()
+	if _, err := returns.ApproveReturn(ctx, &pb.ApproveReturnRequest{ReturnId: returnID}); err != nil {
+		t.Fatalf("ApproveReturn: %v", err)
+	}
+	if _, err := returns.ReceiveReturn(ctx, &pb.ReceiveReturnRequest{ReturnId: returnID, Restock: true}); err != nil {
+		t.Fatalf("ReceiveReturn: %v", err)
+	}
+	refunded, err := returns.RefundReturn(ctx, &pb.RefundReturnRequest{ReturnId: returnID})
+	if err != nil {
+		t.Fatalf("RefundReturn: %v", err)
+	}
+	if refunded.GetStatus() != pb.ReturnStatus_RETURN_STATUS_REFUNDED {
+		t.Errorf("return is %v, want refunded", refunded.GetStatus())
+	}
+
+	// The payment gave back one mug; the order keeps the other
+	want := models.NewMoney(1250, models.DefaultCurrency).ToPb()
+	payment, err := payments.GetPayment(ctx, &pb.GetPaymentRequest{PaymentId: paymentID})
+	if err != nil {
+		t.Fatalf("GetPayment: %v", err)
+	}
+	if payment.GetStatus() != pb.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED {
+		t.Errorf("payment is %v, want partially refunded", payment.GetStatus())
+	}
+	if !proto.Equal(payment.GetRefundedAmount(), want) {
+		t.Errorf("refunded %v, want %v", payment.GetRefundedAmount(), want)
+	}
+	order, err := orders.GetOrderById(ctx, &pb.GetOrderRequest{OrderId: orderID})
+	if err != nil {
+		t.Fatalf("GetOrderById: %v", err)
+	}
+	if status := order.GetOrderResponse().GetOrderStatus(); status != pb.OrderStatus_ORDER_STATUS_DELIVERED {
+		t.Errorf("order is %v, want still delivered", status)
+	}
+}
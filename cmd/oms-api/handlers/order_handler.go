@@ -9,6 +9,7 @@ import (
 
 	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
 	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"github.com/keyurKalariya/OMS/cmd/oms-api/repository"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
@@ -16,192 +17,367 @@ import (
 
 type OrderServiceServer struct {
 	pb.UnimplementedOrderServiceServer
-	DB *gorm.DB
+	DB               *gorm.DB
+	PageTokens       *PageTokenCodec
+	IdempotencyTTL   time.Duration         // How long idempotency keys are remembered; DefaultIdempotencyTTL when zero
+	Tax              TaxCalculator         // TableTaxCalculator when nil
+	PricesIncludeTax bool                  // Whether item prices include tax; new orders keep the setting they were created with
+	Payments         *PaymentServiceServer // Authorizes and voids the payments of checkouts
 }
 
+// taxCalculator returns the configured tax calculator
+func (s *OrderServiceServer) taxCalculator() TaxCalculator {
+	if s.Tax == nil {
+		return TableTaxCalculator{}
+	}
+	return s.Tax
+}
+
+// CreateOrder creates an order; retries carrying the same idempotency key replay the first response
 func (s *OrderServiceServer) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.OrderResponse, error) {
+	// Customers only place orders for themselves
+	if err := authorizeOwner(ctx, models.PermOrdersWrite, req.GetOrder().GetUserId()); err != nil {
+		return nil, err
+	}
 
-	// Bind the incoming request to the Order model
-	var newOrder models.Order
-	newOrder.UserID = req.GetOrder().UserId
+	key := idempotencyKeyFrom(ctx, req.GetIdempotencyKey())
+	return idempotent(ctx, s.DB, s.IdempotencyTTL, pb.OrderService_CreateOrder_FullMethodName, key, req, func() (*pb.OrderResponse, error) {
+		return s.createOrder(ctx, req)
+	})
+}
 
-	// Initialize total price and order items
-	var totalPrice int32
-	var orderItems []models.OrderItem
+// orderLinesFromRequest validates an incoming order and prices every line from the item catalogue in the
+// currency of converter
+func orderLinesFromRequest(db *gorm.DB, order *pb.Order, converter *currencyConverter) ([]models.OrderItem, error) {
+	// Validate the incoming order
+	if order == nil || len(order.GetItems()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "An order with at least one item is required")
+	}
+	for _, item := range order.GetItems() {
+		if item.GetQuantity() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Quantity for item %d must be positive", item.GetItemId())
+		}
+	}
 
-	// Loop through the OrderItems to calculate the total price
-	for _, item := range req.GetOrder().Items {
-		var itemRecord models.Item
-		// Use GORM's First method to get the item by ID
-		if err := s.DB.First(&itemRecord, item.ItemId).Error; err != nil {
-			log.Println("Error fetching item for item ID", item.ItemId, ":", err)
-			// Return error status with message
-			return &pb.OrderResponse{
-				OrderResponse: &pb.OrderResponse1{
-					Status: "Failed to fetch item",
-				},
-			}, nil
+	// Ensure the ordering user exists
+	var user models.User
+	if err := db.Where("id = ? AND deleted_at IS NULL", order.GetUserId()).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown user ID: %d", order.GetUserId())
 		}
+		log.Println("Error fetching user for user ID", order.GetUserId(), ":", err)
+		return nil, status.Errorf(codes.Internal, "Failed to fetch user")
+	}
 
-		// Extract the price from the fetched item record
-		price := itemRecord.Price
+	// Price every line from the item catalogue
+	var lines []models.OrderItem
+	for _, item := range order.GetItems() {
+		var itemRecord models.Item
+		if err := db.First(&itemRecord, item.GetItemId()).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.InvalidArgument, "Unknown item ID: %d", item.GetItemId())
+			}
+			log.Println("Error fetching item for item ID", item.GetItemId(), ":", err)
+			return nil, status.Errorf(codes.Internal, "Failed to fetch item")
+		}
 
-		// Calculate the total price
-		totalPrice += price * int32(item.Quantity)
+		// Price the line in the order currency
+		price, err := converter.itemPrice(&itemRecord)
+		if err != nil {
+			return nil, err
+		}
 
 		// Populate item details, including price
-		orderItem := models.OrderItem{
-			ItemID:   item.ItemId,
-			Quantity: item.Quantity,
-			Price:    float64(price),
-		}
-		// Add item to the orderItems array
-		orderItems = append(orderItems, orderItem)
-	}
-
-	// Calculate discounts based on predefined conditions
-	discounts := calculateDiscounts(s.DB, newOrder, orderItems)
-
-	// Calculate the final price after applying discounts
-	finalPrice := calculateTotalPrice(s.DB, orderItems, discounts)
-
-	// Set the total and final price in the order object
-	newOrder.TotalPrice = float64(totalPrice)
-	newOrder.FinalPrice = finalPrice
-	newOrder.Status = "Pending"
-
-	// Insert the new order into the database
-	if err := s.DB.Create(&newOrder).Error; err != nil {
-		log.Println("Error inserting order:", err)
-		// Return error status with message
-		return &pb.OrderResponse{
-			OrderResponse: &pb.OrderResponse1{
-				Status: "Failed to insert order",
-			},
-		}, nil
+		lines = append(lines, models.OrderItem{
+			ItemID:      item.GetItemId(),
+			Quantity:    item.GetQuantity(),
+			Price:       price,
+			TaxCategory: itemRecord.TaxCategory,
+		})
 	}
+	return lines, nil
+}
 
-	// Insert items into the order_items table using GORM
-	for _, item := range orderItems {
-		item.OrderID = newOrder.ID
-		if err := s.DB.Create(&item).Error; err != nil {
-			log.Println("Error inserting order item:", err)
-			// Return error status with message
-			return &pb.OrderResponse{
-				OrderResponse: &pb.OrderResponse1{
-					Status: "Failed to insert order item",
-				},
-			}, nil
-		}
+func (s *OrderServiceServer) createOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.OrderResponse, error) {
+	// Resolve the currency to price the order in
+	currency, err := resolveOrderCurrency(req.GetCurrency())
+	if err != nil {
+		return nil, err
 	}
 
-	// Insert user_id and order_id into the userOrder table
-	if err := s.DB.Model(&models.UserOrder{}).Create(&models.UserOrder{
-		UserID:  newOrder.UserID,
-		OrderID: newOrder.ID,
-	}).Error; err != nil {
-		log.Println("Error inserting into userOrder table:", err)
-		// Return error status with message
-		return &pb.OrderResponse{
-			OrderResponse: &pb.OrderResponse1{
-				Status: "Failed to insert into userOrder table",
-			},
-		}, nil
-	}
+	// Bind the incoming request to the Order model
+	newOrder := models.Order{
+		UserID:           req.GetOrder().GetUserId(),
+		Status:           models.OrderStatusPending,
+		PricesIncludeTax: s.PricesIncludeTax,
+	}
+
+	// Write the order, its items, the user link and the stock reservation in one transaction
+	// so a failure at any step leaves nothing behind
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Validate the order and price its lines
+		converter := newCurrencyConverter(tx, currency)
+		lines, err := orderLinesFromRequest(tx, req.GetOrder(), converter)
+		if err != nil {
+			return err
+		}
+		newOrder.Items = lines
 
-	// Create the response with order details
-	var orderItemsResponse []*pb.OrderItemForResponse
-	for _, item := range orderItems {
-		orderItemsResponse = append(orderItemsResponse, &pb.OrderItemForResponse{
-			ItemId:   item.ItemID,
-			Quantity: item.Quantity,
-			Price:    float64(item.Price),
-		})
-	}
+		// Copy the shipping and billing address; billing falls back to the shipping address
+		shipping, err := resolveOrderAddress(tx, newOrder.UserID, models.OrderAddressShipping, req.GetShippingAddressId(), req.GetShippingAddress())
+		if err != nil {
+			return err
+		}
+		billing, err := resolveOrderAddress(tx, newOrder.UserID, models.OrderAddressBilling, req.GetBillingAddressId(), req.GetBillingAddress())
+		if err != nil {
+			return err
+		}
+		if billing == nil && shipping != nil {
+			copied := *shipping
+			copied.Kind = models.OrderAddressBilling
+			billing = &copied
+		}
+		for _, address := range []*models.OrderAddress{shipping, billing} {
+			if address != nil {
+				newOrder.Addresses = append(newOrder.Addresses, *address)
+			}
+		}
+		newOrder.TaxRegion = orderTaxRegion(req.GetTaxRegion(), shipping)
+
+		// Calculate discounts, including the coupon, tax and the final price
+		if _, err := priceOrder(tx, &newOrder, req.GetCouponCode(), time.Now(), converter, s.taxCalculator()); err != nil {
+			return err
+		}
+
+		// Insert the order together with its items as one aggregate
+		if err := tx.Create(&newOrder).Error; err != nil {
+			log.Println("Error inserting order:", err)
+			return status.Errorf(codes.Internal, "Failed to insert order")
+		}
+
+		// Insert user_id and order_id into the userOrder table
+		if err := tx.Create(&models.UserOrder{UserID: newOrder.UserID, OrderID: newOrder.ID}).Error; err != nil {
+			log.Println("Error inserting into userOrder table:", err)
+			return status.Errorf(codes.Internal, "Failed to link order to user")
+		}
+
+		// Count the coupon redemption against its limits; the adjustments, exchange rates and addresses were inserted with the order
+		if err := redeemCoupon(tx, &newOrder, nil); err != nil {
+			return err
+		}
+
+		// Reserve stock for every line
+		if err := applyStockChange(tx, &newOrder.ID, newOrder.Items, reserveChange); err != nil {
+			return err
+		}
 
-	// Construct the OrderResponse1 struct first
-	orderResponse := &pb.OrderResponse1{
-		Id:         int32(newOrder.ID),
-		UserId:     newOrder.UserID,
-		TotalPrice: newOrder.TotalPrice,
-		Status:     "Pending", // Status can be dynamic or based on conditions
-		FinalPrice: finalPrice,
-		Items:      orderItemsResponse,
+		// Tell subscribers about the new order
+		return recordOrderEvent(tx, models.EventOrderCreated, newOrder.ID, "")
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Return the response
 	return &pb.OrderResponse{
-		OrderResponse: orderResponse,
+		OrderResponse: newOrder.ToPb(),
 	}, nil
 }
 
-func (s *OrderServiceServer) GetAllOrders(ctx context.Context, req *pb.GetAllOrdersRequest) (*pb.AllOrderReponse, error) {
-	var orders []models.Order
+// QuoteOrder prices an order the way CreateOrder would, without writing anything. Quotes for the same
+// as_of instant are repeatable as long as the rules, coupons and item prices do not change.
+func (s *OrderServiceServer) QuoteOrder(ctx context.Context, req *pb.QuoteOrderRequest) (*pb.QuoteOrderResponse, error) {
+	if err := authorizeOwner(ctx, models.PermOrdersWrite, req.GetOrder().GetUserId()); err != nil {
+		return nil, err
+	}
 
-	// Fetch orders with GORM, excluding soft-deleted orders
-	if err := s.DB.Where("deleted_at IS NULL").Find(&orders).Error; err != nil {
-		log.Println("Error fetching orders:", err)
-		return nil, status.Error(codes.Internal, "Unable to fetch orders")
+	// Resolve the instant to price at
+	asOf := time.Now()
+	if req.GetAsOf() != "" {
+		parsed, err := parseTimeFilter("as_of", req.GetAsOf())
+		if err != nil {
+			return nil, err
+		}
+		asOf = *parsed
 	}
 
-	var responseOrders []*pb.OrderResponse1
+	currency, err := resolveOrderCurrency(req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
 
-	// Iterate through each order to fetch associated order items
-	for _, order := range orders {
-		// Initialize the order response
-		orderResponse := &pb.OrderResponse1{
-			Id:         int32(order.ID),
-			UserId:     int32(order.UserID),
-			TotalPrice: order.TotalPrice,
-			FinalPrice: order.FinalPrice,
-			Status:     order.Status,
-		}
+	// Validate the order and price its lines
+	db := s.DB.WithContext(ctx)
+	converter := newCurrencyConverter(db, currency)
+	lines, err := orderLinesFromRequest(db, req.GetOrder(), converter)
+	if err != nil {
+		return nil, err
+	}
+	shipping, err := resolveOrderAddress(db, req.GetOrder().GetUserId(), models.OrderAddressShipping, req.GetShippingAddressId(), req.GetShippingAddress())
+	if err != nil {
+		return nil, err
+	}
+	order := models.Order{
+		UserID:           req.GetOrder().GetUserId(),
+		Items:            lines,
+		TaxRegion:        orderTaxRegion(req.GetTaxRegion(), shipping),
+		PricesIncludeTax: s.PricesIncludeTax,
+	}
 
-		// Fetch order items using GORM
-		var items []models.OrderItem
-		if err := s.DB.Model(&models.OrderItem{}).Where("order_id = ?", order.ID).Find(&items).Error; err != nil {
-			log.Println("Error fetching order items for order ID:", order.ID, err)
-			return nil, status.Error(codes.Internal, "Unable to fetch order items")
+	// Evaluate the discount rules and the coupon without locking or redeeming anything
+	discounts, err := calculateDiscounts(db, order, lines, asOf, converter)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetCouponCode() != "" {
+		if err := applyCoupon(db, &order, req.GetCouponCode(), asOf, &discounts, false, converter); err != nil {
+			return nil, err
 		}
+	}
 
-		// Create a map to aggregate items by ItemID
-		itemMap := make(map[int32]*pb.OrderItemForResponse)
+	// Tax the discounted lines
+	if err := applyTax(db, &order, discounts, s.taxCalculator()); err != nil {
+		return nil, err
+	}
 
-		// Iterate over order items and aggregate the data
-		for _, item := range items {
-			if existingItem, found := itemMap[int32(item.ItemID)]; found {
-				// If the item already exists, update the quantity and price
-				existingItem.Quantity += int32(item.Quantity)
-				existingItem.Price += item.Price
-			} else {
-				// If the item does not exist, add it to the map
-				itemMap[int32(item.ItemID)] = &pb.OrderItemForResponse{
-					ItemId:   int32(item.ItemID),
-					Quantity: int32(item.Quantity),
-					Price:    item.Price,
-				}
-			}
-		}
+	subtotal := models.NewMoney(orderSubtotal(lines), discounts.Currency)
+	discountTotal := models.NewMoney(discounts.TotalDiscountAmount, discounts.Currency)
+	response := &pb.QuoteOrderResponse{
+		Subtotal:           subtotal.Major(),
+		DiscountTotal:      discountTotal.Major(),
+		TaxTotal:           order.TaxTotal.Major(),
+		Total:              order.FinalPrice.Major(),
+		AsOf:               asOf.Format(time.RFC3339Nano),
+		SubtotalMoney:      subtotal.ToPb(),
+		DiscountTotalMoney: discountTotal.ToPb(),
+		TaxTotalMoney:      order.TaxTotal.ToPb(),
+		TotalMoney:         order.FinalPrice.ToPb(),
+		PricesIncludeTax:   order.PricesIncludeTax,
+	}
+	for i := range order.Items {
+		response.Items = append(response.Items, order.Items[i].ToPb())
+	}
+	adjustments := models.AdjustmentsFromDiscounts(discounts)
+	for i := range adjustments {
+		response.Discounts = append(response.Discounts, adjustments[i].ToPb())
+	}
+	rates := converter.snapshot()
+	for i := range rates {
+		response.ExchangeRates = append(response.ExchangeRates, rates[i].ToPb())
+	}
+	return response, nil
+}
 
-		// Convert the itemMap to a slice and add it to the response
-		for _, aggregatedItem := range itemMap {
-			orderResponse.Items = append(orderResponse.Items, aggregatedItem)
+// listOrders fetches the page of orders matching req that starts at pageToken
+func (s *OrderServiceServer) listOrders(ctx context.Context, req *pb.GetAllOrdersRequest, pageToken string) ([]models.Order, string, error) {
+	// Build the filtered query over non-deleted orders
+	query := s.DB.WithContext(ctx).Model(&models.Order{})
+	if req.GetStatus() != pb.OrderStatus_ORDER_STATUS_UNSPECIFIED {
+		orderStatus, ok := models.OrderStatusFromPb(req.GetStatus())
+		if !ok {
+			return nil, "", status.Errorf(codes.InvalidArgument, "Unknown order status filter")
 		}
+		query = query.Where("status = ?", orderStatus)
+	}
+	if req.GetUserId() != 0 {
+		query = query.Where("user_id = ?", req.GetUserId())
+	}
+	createdAfter, err := parseTimeFilter("created_after", req.GetCreatedAfter())
+	if err != nil {
+		return nil, "", err
+	}
+	if createdAfter != nil {
+		query = query.Where("created_at >= ?", *createdAfter)
+	}
+	createdBefore, err := parseTimeFilter("created_before", req.GetCreatedBefore())
+	if err != nil {
+		return nil, "", err
+	}
+	if createdBefore != nil {
+		query = query.Where("created_at < ?", *createdBefore)
+	}
+
+	page := pageRequest{PageSize: req.GetPageSize(), PageToken: pageToken, OrderBy: req.GetOrderBy()}
+	return listPage(query, s.PageTokens, req, page, func(order *models.Order) (time.Time, int32) {
+		return order.CreatedAt, order.ID
+	})
+}
+
+// loadOrderDetails loads the items, with their names, the adjustments, the exchange rates, the addresses and the shipments of orders
+func loadOrderDetails(ctx context.Context, db *gorm.DB, orders []models.Order) error {
+	reader := repository.NewOrderReader(db)
+	if err := reader.LoadItems(ctx, orders, true); err != nil {
+		log.Println("Error fetching order items:", err)
+		return status.Error(codes.Internal, "Unable to fetch order items")
+	}
+	if err := reader.LoadAdjustments(ctx, orders); err != nil {
+		log.Println("Error fetching order adjustments:", err)
+		return status.Error(codes.Internal, "Unable to fetch order adjustments")
+	}
+	if err := reader.LoadExchangeRates(ctx, orders); err != nil {
+		log.Println("Error fetching order exchange rates:", err)
+		return status.Error(codes.Internal, "Unable to fetch order exchange rates")
+	}
+	if err := reader.LoadAddresses(ctx, orders); err != nil {
+		log.Println("Error fetching order addresses:", err)
+		return status.Error(codes.Internal, "Unable to fetch order addresses")
+	}
+	if err := reader.LoadShipments(ctx, orders); err != nil {
+		log.Println("Error fetching order shipments:", err)
+		return status.Error(codes.Internal, "Unable to fetch order shipments")
+	}
+	return nil
+}
+
+func (s *OrderServiceServer) GetAllOrders(ctx context.Context, req *pb.GetAllOrdersRequest) (*pb.AllOrderReponse, error) {
+	// Fetch one page of orders
+	orders, nextPageToken, err := s.listOrders(ctx, req, req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
 
-		// Append the fully populated order response to the final response slice
-		responseOrders = append(responseOrders, orderResponse)
+	// Load the items and adjustments of the whole page with one query each
+	if err := loadOrderDetails(ctx, s.DB, orders); err != nil {
+		return nil, err
 	}
 
-	// Return all orders with their aggregated items
+	responseOrders := make([]*pb.OrderResponse1, len(orders))
+	for i := range orders {
+		responseOrders[i] = orders[i].ToPb()
+	}
+
+	// Return the page of orders with their items
 	return &pb.AllOrderReponse{
-		Orders: responseOrders,
+		Orders:        responseOrders,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
+// StreamOrders sends every order matching the request filters together with its items and adjustments. Orders are read
+// in batches and the items and adjustments of a batch are loaded with a single query each.
+func (s *OrderServiceServer) StreamOrders(req *pb.GetAllOrdersRequest, stream pb.OrderService_StreamOrdersServer) error {
+	ctx := stream.Context()
+	return streamPages(ctx, req.GetPageToken(), func(pageToken string) (string, error) {
+		orders, nextPageToken, err := s.listOrders(ctx, req, pageToken)
+		if err != nil {
+			return "", err
+		}
+		if err := loadOrderDetails(ctx, s.DB, orders); err != nil {
+			return "", err
+		}
+		for i := range orders {
+			if err := stream.Send(orders[i].ToPb()); err != nil {
+				return "", err
+			}
+		}
+		return nextPageToken, nil
+	})
+}
+
 func (s *OrderServiceServer) GetOrderById(ctx context.Context, req *pb.GetOrderRequest) (*pb.OrderResponse, error) {
 	// Fetch the order by ID, excluding soft-deleted records
 	var order models.Order
-	if err := s.DB.Where("id = ? AND deleted_at IS NULL", req.OrderId).First(&order).Error; err != nil {
+	if err := s.DB.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", req.GetOrderId()).First(&order).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, status.Errorf(codes.NotFound, "Order not found")
 		}
@@ -209,34 +385,20 @@ func (s *OrderServiceServer) GetOrderById(ctx context.Context, req *pb.GetOrderR
 		return nil, status.Errorf(codes.Internal, "Unable to fetch order data")
 	}
 
-	// Fetch the order items for the specific order
-	var items []models.OrderItem
-	if err := s.DB.Where("order_id = ?", order.ID).Find(&items).Error; err != nil {
-		log.Println("Error fetching order items:", err)
-		return nil, status.Errorf(codes.Internal, "Unable to fetch order items")
+	// Customers only see their own orders
+	if err := authorizeOwner(ctx, models.PermOrdersRead, order.UserID); err != nil {
+		return nil, err
 	}
 
-	// Prepare the gRPC OrderResponse1 structure
-	orderResponse := &pb.OrderResponse1{
-		Id:         int32(order.ID),
-		UserId:     int32(order.UserID),
-		TotalPrice: order.TotalPrice,
-		FinalPrice: order.FinalPrice,
-		Status:     order.Status,
-	}
-
-	// Map the items to gRPC OrderItemForResponse
-	for _, item := range items {
-		orderResponse.Items = append(orderResponse.Items, &pb.OrderItemForResponse{
-			ItemId:   int32(item.ItemID),
-			Quantity: int32(item.Quantity),
-			Price:    item.Price,
-		})
+	// Fetch the order items and adjustments for the specific order
+	orders := []models.Order{order}
+	if err := loadOrderDetails(ctx, s.DB, orders); err != nil {
+		return nil, err
 	}
 
 	// Return the response wrapped in OrderResponse
 	return &pb.OrderResponse{
-		OrderResponse: orderResponse,
+		OrderResponse: orders[0].ToPb(),
 	}, nil
 }
 
@@ -244,8 +406,13 @@ func (s *OrderServiceServer) UpdateOrderById(ctx context.Context, req *pb.Update
 	// Extract the order ID from the request
 	orderID := req.GetOrderId()
 
+	// Customers only change their own orders
+	if err := authorizeOrder(ctx, s.DB, models.PermOrdersWrite, orderID); err != nil {
+		return nil, err
+	}
+
 	// Start a GORM transaction to ensure atomic updates
-	tx := s.DB.Begin()
+	tx := s.DB.WithContext(ctx).Begin()
 	if tx.Error != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to start transaction")
 	}
@@ -261,10 +428,19 @@ func (s *OrderServiceServer) UpdateOrderById(ctx context.Context, req *pb.Update
 		return nil, status.Errorf(codes.Internal, "Failed to fetch order")
 	}
 
-	// Update the order status if it has changed
-	if existingOrder.Status != "" && existingOrder.Status != "Confirmed" {
-		if err := tx.Model(&existingOrder).Update("status", existingOrder.Status).Error; err != nil {
-			return nil, status.Errorf(codes.Internal, "Failed to update order status")
+	// Only orders that have not been confirmed yet may have their items changed
+	if !existingOrder.Status.IsEditable() {
+		return nil, status.Errorf(codes.FailedPrecondition, "Order items cannot be changed in status '%s'", existingOrder.Status)
+	}
+
+	// Pending orders hold a reservation for their current lines; give it back before the lines are replaced
+	var oldItems []models.OrderItem
+	if err := tx.Where("order_id = ?", orderID).Find(&oldItems).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch order items")
+	}
+	if existingOrder.Status == models.OrderStatusPending {
+		if err := applyStockChange(tx, &orderID, oldItems, releaseChange); err != nil {
+			return nil, err
 		}
 	}
 
@@ -273,47 +449,83 @@ func (s *OrderServiceServer) UpdateOrderById(ctx context.Context, req *pb.Update
 		return nil, status.Errorf(codes.Internal, "Failed to delete old order items")
 	}
 
-	// Process each item in the request
-	var orderItemsForResponse []*pb.OrderItemForResponse
-	for _, updatedItem := range req.GetItems() {
-		var itemPrice float64
+	// Lines are priced in the currency the order was created in, at the exchange rates it was priced with
+	currency := existingOrder.TotalPrice.Currency
+	if currency == "" {
+		currency = models.DefaultCurrency
+	}
+	var rates []models.OrderExchangeRate
+	if err := tx.Where("order_id = ?", orderID).Find(&rates).Error; err != nil {
+		log.Println("Error fetching order exchange rates:", err)
+		return nil, status.Errorf(codes.Internal, "Failed to fetch order exchange rates")
+	}
+	converter := newOrderCurrencyConverter(tx, currency, rates)
 
-		// Fetch the price of the item from the database
-		if err := tx.Model(&models.Item{}).Where("id = ?", updatedItem.GetItemId()).Pluck("price", &itemPrice).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return nil, status.Errorf(codes.InvalidArgument, "Invalid item ID: %d", updatedItem.GetItemId())
-			}
-			return nil, status.Errorf(codes.Internal, "Failed to fetch item price")
-		}
+	// Validate and price the new lines the way CreateOrder does; they are inserted once their discount and tax are known
+	newItems, err := orderLinesFromRequest(tx, &pb.Order{UserId: existingOrder.UserID, Items: req.GetItems()}, converter)
+	if err != nil {
+		return nil, err
+	}
+	for i := range newItems {
+		newItems[i].OrderID = orderID
+	}
 
-		// Insert the new item
-		orderItem := models.OrderItem{
-			OrderID:  orderID,
-			ItemID:   updatedItem.GetItemId(),
-			Quantity: updatedItem.GetQuantity(),
-			Price:    itemPrice,
+	// Reserve stock for the new lines of a pending order
+	if existingOrder.Status == models.OrderStatusPending {
+		if err := applyStockChange(tx, &orderID, newItems, reserveChange); err != nil {
+			return nil, err
 		}
-		if err := tx.Create(&orderItem).Error; err != nil {
+	}
+
+	// Recalculate discounts and prices for the new lines; without a new coupon code the current one is
+	// kept, unless it is to be removed
+	if req.GetRemoveCoupon() && req.GetCouponCode() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "coupon_code cannot be set together with remove_coupon")
+	}
+	previousCouponID := existingOrder.CouponID
+	couponCode := req.GetCouponCode()
+	if couponCode == "" && !req.GetRemoveCoupon() {
+		couponCode = existingOrder.CouponCode
+	}
+	existingOrder.Items = newItems
+	if _, err := priceOrder(tx, &existingOrder, couponCode, time.Now(), converter, s.taxCalculator()); err != nil {
+		return nil, err
+	}
+
+	// Insert the new items
+	if len(existingOrder.Items) > 0 {
+		if err := tx.Create(&existingOrder.Items).Error; err != nil {
 			return nil, status.Errorf(codes.Internal, "Failed to insert order item")
 		}
+	}
 
-		// Append the item to the response list
-		orderItemsForResponse = append(orderItemsForResponse, &pb.OrderItemForResponse{
-			ItemId:   updatedItem.GetItemId(),
-			Quantity: updatedItem.GetQuantity(),
-			Price:    itemPrice,
-		})
+	// Update the prices and the coupon in the orders table
+	if err := tx.Model(&models.Order{}).Where("id = ?", orderID).Updates(map[string]interface{}{
+		"total_price_minor_units": existingOrder.TotalPrice.MinorUnits,
+		"total_price_currency":    existingOrder.TotalPrice.Currency,
+		"final_price_minor_units": existingOrder.FinalPrice.MinorUnits,
+		"final_price_currency":    existingOrder.FinalPrice.Currency,
+		"tax_total_minor_units":   existingOrder.TaxTotal.MinorUnits,
+		"tax_total_currency":      existingOrder.TaxTotal.Currency,
+		"coupon_id":               existingOrder.CouponID,
+		"coupon_code":             existingOrder.CouponCode,
+	}).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to update order total price")
 	}
 
-	// Recalculate the total price for the order
-	var totalPrice float64
-	if err := tx.Model(&models.OrderItem{}).Where("order_id = ?", orderID).Select("SUM(price * quantity)").Scan(&totalPrice).Error; err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to recalculate total price")
+	// Replace the persisted discount breakdown and exchange rates
+	if err := replaceOrderBreakdown(tx, &existingOrder); err != nil {
+		return nil, err
 	}
 
-	// Update the total price in the orders table
-	if err := tx.Model(&models.Order{}).Where("id = ?", orderID).Update("total_price", totalPrice).Error; err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to update order total price")
+	// Move the coupon redemption over when the coupon changed
+	if err := redeemCoupon(tx, &existingOrder, previousCouponID); err != nil {
+		return nil, err
+	}
+
+	// Tell subscribers about the change
+	if err := recordOrderEvent(tx, models.EventOrderUpdated, orderID, ""); err != nil {
+		return nil, err
 	}
 
 	// Commit the transaction if everything is successful
@@ -322,32 +534,24 @@ func (s *OrderServiceServer) UpdateOrderById(ctx context.Context, req *pb.Update
 	}
 
 	// Prepare and return the response
-	response := &pb.OrderResponse1{
-		Id:         int32(existingOrder.ID),
-		UserId:     int32(existingOrder.UserID),
-		TotalPrice: totalPrice,
-		Status:     existingOrder.Status,
-		FinalPrice: totalPrice, // Assuming no discounts are applied for simplicity
-		Items:      orderItemsForResponse,
-	}
-
-	return response, nil
+	return existingOrder.ToPb(), nil
 }
 
+// UpdateOrderStatusByOrderId checks out a pending order with its authorized payment; retries carrying the same idempotency key replay the first response
 func (s *OrderServiceServer) UpdateOrderStatusByOrderId(ctx context.Context, req *pb.UpdateOrderStatusRequest) (*pb.UpdateOrderStatusResponse, error) {
+	key := idempotencyKeyFrom(ctx, req.GetIdempotencyKey())
+	return idempotent(ctx, s.DB, s.IdempotencyTTL, pb.OrderService_UpdateOrderStatusByOrderId_FullMethodName, key, req, func() (*pb.UpdateOrderStatusResponse, error) {
+		return s.updateOrderStatusByOrderId(ctx, req)
+	})
+}
+
+func (s *OrderServiceServer) updateOrderStatusByOrderId(ctx context.Context, req *pb.UpdateOrderStatusRequest) (*pb.UpdateOrderStatusResponse, error) {
 	// Extract the order ID from the request
 	orderID := req.GetOrderId()
 
-	// Start a GORM transaction to ensure atomic updates
-	tx := s.DB.Begin()
-	if tx.Error != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to start transaction")
-	}
-	defer tx.Rollback() // Ensure rollback in case of failure
-
 	// Fetch the current order
 	var order models.Order
-	if err := tx.Where("id = ? AND deleted_at IS NULL", orderID).First(&order).Error; err != nil {
+	if err := s.DB.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", orderID).First(&order).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, status.Errorf(codes.NotFound, "Order not found")
 		}
@@ -355,27 +559,26 @@ func (s *OrderServiceServer) UpdateOrderStatusByOrderId(ctx context.Context, req
 	}
 
 	// Check if the order status is 'Pending'
-	if order.Status != "Pending" {
+	if order.Status != models.OrderStatusPending {
 		return &pb.UpdateOrderStatusResponse{
 			Message:       fmt.Sprintf("Order status is not 'Pending' (current status: %s)", order.Status),
-			CurrentStatus: order.Status,
+			CurrentStatus: string(order.Status),
 		}, nil
 	}
 
-	// Update the status to 'Confirm'
-	if err := tx.Model(&order).Update("status", "Confirm").Error; err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to update order status")
+	// Check the order out with the payment already authorized for it
+	response, err := s.checkout(ctx, orderID, "")
+	if err != nil {
+		return nil, err
 	}
-
-	// Commit the transaction if successful
-	if err := tx.Commit().Error; err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to commit transaction")
+	if response.GetCheckout().GetStatus() != pb.CheckoutStatus_CHECKOUT_STATUS_COMPLETED {
+		return nil, status.Errorf(codes.FailedPrecondition, "Order could not be confirmed: %s", response.GetCheckout().GetError())
 	}
 
 	// Return the success response
 	return &pb.UpdateOrderStatusResponse{
 		Message:       "Order has been confirmed and placed successfully",
-		CurrentStatus: "Confirm",
+		CurrentStatus: string(models.OrderStatusConfirmed),
 	}, nil
 }
 
@@ -383,41 +586,17 @@ func (s *OrderServiceServer) UpdateOrderStatusByOrderId(ctx context.Context, req
 func (s *OrderServiceServer) DeleteOrderById(ctx context.Context, req *pb.DeleteOrderRequest) (*pb.DeleteOrderResponse, error) {
 	id := req.GetOrderId()
 
-	// Start a GORM transaction to ensure the order is deleted properly
-	tx := s.DB.Begin()
-	if tx.Error != nil {
-		return nil, fmt.Errorf("failed to start transaction: %v", tx.Error)
-	}
-	defer tx.Rollback() // Ensure rollback in case of error
-
-	// Fetch the existing order to check if it is already deleted
-	var order models.Order
-	if err := tx.Where("id = ? AND deleted_at IS NULL", id).First(&order).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return &pb.DeleteOrderResponse{
-				Message: "Order not found",
-			}, nil
+	// Move the order to "Cancelled", giving the customer's money back, and mark it as deleted; deleted
+	// orders are not found again
+	_, _, err := s.cancelOrder(ctx, id, "Order deleted", func(tx *gorm.DB, order *models.Order) error {
+		if err := tx.Delete(order).Error; err != nil {
+			log.Println("Error deleting order:", err)
+			return status.Errorf(codes.Internal, "Failed to delete order")
 		}
-		return nil, fmt.Errorf("failed to fetch order: %v", err)
-	}
-
-	// If the order is already deleted, return an error
-	if order.DeletedAt.Valid {
-		return &pb.DeleteOrderResponse{
-			Message: "Order already deleted",
-		}, nil
-	}
-
-	// Mark the order as deleted and update its status to "Cancelled"
-	order.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
-	order.Status = "Cancelled"
-	if err := tx.Save(&order).Error; err != nil {
-		return nil, fmt.Errorf("failed to delete order: %v", err)
-	}
-
-	// Commit the transaction if everything is successful
-	if err := tx.Commit().Error; err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Return the success response
@@ -426,59 +605,183 @@ func (s *OrderServiceServer) DeleteOrderById(ctx context.Context, req *pb.Delete
 	}, nil
 }
 
-// UpdateOrderStatusByOrderId updates the order status to 'Confirm' if it is currently 'Pending'
-
-func calculateDiscounts(db *gorm.DB, order models.Order, items []models.OrderItem) models.Discounts {
-	discounts := models.Discounts{}
-
-	// Seasonal discount (e.g., December 3 - December 31)
-	currentDate := time.Now()
-	if currentDate.Month() == time.December && currentDate.Day() >= 3 && currentDate.Day() <= 31 {
-		discounts.SeasonalDiscount = 0.15
-		log.Println("Seasonal discount applied: 15%")
+// calculateDiscounts evaluates the active discount rules against an order. Rules are evaluated by descending
+// priority. Stackable rules add up; an exclusive rule only applies when no rule has applied before it, and
+// then stops the evaluation. The total discount never exceeds the order subtotal; amounts beyond it are
+// trimmed from the last applied discounts, so the applied discounts always add up to the total.
+//
+// All amounts are minor units of the order currency, the target currency of converter. Percentage discounts
+// are rounded half away from zero to the minor unit, once per discount line. Fixed discounts in another
+// currency are converted first; rules without an exchange rate into the order currency do not apply.
+//
+// Rules are evaluated as of now, so a fixed now gives a repeatable result. The customer's order history counts
+// the orders placed before order, or before now for an order not placed yet, so editing an order keeps the
+// history it was placed with.
+func calculateDiscounts(db *gorm.DB, order models.Order, items []models.OrderItem, now time.Time, converter *currencyConverter) (models.Discounts, error) {
+	currency := converter.target
+	discounts := models.Discounts{Currency: currency}
+
+	// Load the active rules in evaluation order
+	var rules []models.DiscountRule
+	if err := db.Where("active = ?", true).Order("priority DESC, id").Find(&rules).Error; err != nil {
+		log.Printf("Error fetching discount rules: %v", err)
+		return discounts, status.Errorf(codes.Internal, "Failed to fetch discount rules")
+	}
+
+	// Count the user's orders only when a rule depends on it
+	var orderCount int64
+	placedAt := now
+	if !order.CreatedAt.IsZero() {
+		placedAt = order.CreatedAt
+	}
+	for _, rule := range rules {
+		if rule.MinOrderCount > 0 {
+			if err := db.Model(&models.Order{}).Where("user_id = ? AND created_at < ? AND id <> ?", order.UserID, placedAt, order.ID).Count(&orderCount).Error; err != nil {
+				log.Printf("Error fetching user order count: %v", err)
+				return discounts, status.Errorf(codes.Internal, "Failed to fetch user order count")
+			}
+			break
+		}
 	}
 
-	// Volume-based discount (10 or more units of any single item)
-	for _, item := range items {
-		if item.Quantity >= 10 {
-			volumeDiscount := 0.10 * item.Price * float64(item.Quantity)
-			discounts.VolumeBasedDiscount += volumeDiscount
-			log.Printf("Volume discount for item %d: %.2f", item.ItemID, volumeDiscount)
+	// Evaluate fixed rules with their amount in the order currency
+	convertible := make([]models.DiscountRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Kind == models.DiscountKindFixed {
+			amount, err := converter.convert(rule.Amount)
+			if status.Code(err) == codes.FailedPrecondition {
+				log.Printf("Skipping discount rule %d: %v", rule.ID, err)
+				continue
+			}
+			if err != nil {
+				return discounts, err
+			}
+			rule.Amount = amount
 		}
+		convertible = append(convertible, rule)
 	}
 
-	// Loyalty discount (if the user has more than 5 orders)
-	var orderCount int64
-	// Use GORM to count the number of orders for the user
-	err := db.Model(&models.Order{}).Where("user_id = ?", order.UserID).Count(&orderCount).Error
-	if err != nil {
-		log.Printf("Error fetching user order count: %v", err)
+	return applyDiscountRules(convertible, order.UserID, orderCount, now, items, currency), nil
+}
+
+// applyDiscountRules evaluates rules, in evaluation order and with fixed amounts in currency, against the
+// order lines, stacking, excluding and trimming the discounts as calculateDiscounts describes
+func applyDiscountRules(rules []models.DiscountRule, userID int32, orderCount int64, now time.Time, items []models.OrderItem, currency string) models.Discounts {
+	discounts := models.Discounts{Currency: currency}
+	subtotal := orderSubtotal(items)
+	for i := range rules {
+		applied := evaluateDiscountRule(&rules[i], userID, orderCount, now, items, subtotal)
+		if len(applied) == 0 {
+			continue
+		}
+
+		if rules[i].Stacking == models.DiscountStackingExclusive {
+			// An exclusive rule never combines with rules that already applied
+			if len(discounts.Applied) > 0 {
+				continue
+			}
+			discounts.Applied = applied
+			break
+		}
+		discounts.Applied = append(discounts.Applied, applied...)
 	}
 
-	if orderCount >= 5 {
-		discounts.LoyaltyDiscount = 0.05
-		log.Println("Loyalty discount applied: 5%")
+	remaining := subtotal
+	for i := range discounts.Applied {
+		discount := &discounts.Applied[i]
+		if discount.Amount > remaining {
+			discount.Amount = remaining
+		}
+		remaining -= discount.Amount
+		discounts.TotalDiscountAmount += discount.Amount
 	}
 
 	return discounts
 }
 
-func calculateTotalPrice(db *gorm.DB, items []models.OrderItem, discounts models.Discounts) float64 {
-	var totalPrice float64
+// evaluateDiscountRule returns the discounts a single rule grants to an order, or nil when its conditions do not hold.
+// The amount of a fixed rule must already be in the order currency.
+func evaluateDiscountRule(rule *models.DiscountRule, userID int32, orderCount int64, now time.Time, items []models.OrderItem, subtotal int64) []models.AppliedDiscount {
+	if !rule.ActiveAt(now) {
+		return nil
+	}
+	if rule.UserID != nil && *rule.UserID != userID {
+		return nil
+	}
+	if orderCount < int64(rule.MinOrderCount) {
+		return nil
+	}
+
+	// amountOff computes the discount on a base amount covering quantity units
+	amountOff := func(base int64, quantity int32) int64 {
+		amount := rule.Amount.MinorUnits * int64(quantity)
+		if rule.Kind == models.DiscountKindPercentage {
+			amount = models.PercentOf(base, rule.Value)
+		}
+		if amount > base {
+			amount = base
+		}
+		return amount
+	}
+
+	if rule.Scope == models.DiscountScopeLine {
+		// Line rules discount every matching line on its own
+		var applied []models.AppliedDiscount
+		for _, item := range items {
+			if rule.ItemID != nil && *rule.ItemID != item.ItemID {
+				continue
+			}
+			if item.Quantity < rule.MinQuantity {
+				continue
+			}
+			applied = append(applied, models.AppliedDiscount{
+				RuleID: rule.ID,
+				Name:   rule.Name,
+				Kind:   rule.Kind,
+				Scope:  rule.Scope,
+				ItemID: item.ItemID,
+				Amount: amountOff(item.Price.MinorUnits*int64(item.Quantity), item.Quantity),
+				Reason: rule.Describe(),
+			})
+		}
+		return applied
+	}
+
+	// Order rules look at the order as a whole
+	var totalQuantity int32
+	containsItem := rule.ItemID == nil
 	for _, item := range items {
-		totalPrice += item.Price * float64(item.Quantity)
+		totalQuantity += item.Quantity
+		if rule.ItemID != nil && *rule.ItemID == item.ItemID {
+			containsItem = true
+		}
+	}
+	if totalQuantity < rule.MinQuantity || !containsItem {
+		return nil
 	}
 
-	seasonalDiscount := totalPrice * discounts.SeasonalDiscount
-	loyaltyDiscount := totalPrice * discounts.LoyaltyDiscount
-	volumeDiscount := discounts.VolumeBasedDiscount
+	return []models.AppliedDiscount{{
+		RuleID: rule.ID,
+		Name:   rule.Name,
+		Kind:   rule.Kind,
+		Scope:  rule.Scope,
+		Amount: amountOff(subtotal, 1),
+		Reason: rule.Describe(),
+	}}
+}
+
+// calculateTotalPrice returns the subtotal less the discounts, plus the tax unless the prices already include it
+func calculateTotalPrice(items []models.OrderItem, discounts models.Discounts, tax models.Money, pricesIncludeTax bool) models.Money {
+	totalPrice := orderSubtotal(items)
 
-	totalDiscount := seasonalDiscount + loyaltyDiscount + volumeDiscount
+	totalDiscount := discounts.TotalDiscountAmount
 	if totalDiscount > totalPrice {
 		totalDiscount = totalPrice
 	}
 
-	finalPrice := totalPrice - totalDiscount
-	log.Printf("Total Price: %.2f, Discounts: %.2f, Final Price: %.2f", totalPrice, totalDiscount, finalPrice)
+	finalPrice := models.NewMoney(totalPrice-totalDiscount, discounts.Currency)
+	if !pricesIncludeTax {
+		finalPrice.MinorUnits += tax.MinorUnits
+	}
 	return finalPrice
 }
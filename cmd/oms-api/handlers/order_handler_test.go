@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOrderLinesFromRequestValidatesFirst(t *testing.T) {
+	// Invalid orders are refused before the database is asked anything
+	for _, test := range []struct {
+		name  string
+		order *pb.Order
+	}{
+		{"no order", nil},
+		{"no items", &pb.Order{UserId: 1}},
+		{"zero quantity", &pb.Order{UserId: 1, Items: []*pb.OrderItem{{ItemId: 1, Quantity: 1}, {ItemId: 2}}}},
+		{"negative quantity", &pb.Order{UserId: 1, Items: []*pb.OrderItem{{ItemId: 1, Quantity: -1}}}},
+	} {
+		if _, err := orderLinesFromRequest(nil, test.order, nil); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: got %v, want an invalid argument", test.name, err)
+		}
+	}
+}
+
+func TestResolveOrderCurrency(t *testing.T) {
+	for _, test := range []struct {
+		value    string
+		want     string
+		wantCode codes.Code
+	}{
+		{"", models.DefaultCurrency, codes.OK},
+		{" eur ", "EUR", codes.OK},
+		{"EURO", "", codes.InvalidArgument},
+	} {
+		got, err := resolveOrderCurrency(test.value)
+		if got != test.want || status.Code(err) != test.wantCode {
+			t.Errorf("resolveOrderCurrency(%q) = %q, %v, want %q, %v", test.value, got, err, test.want, test.wantCode)
+		}
+	}
+}
+
+func TestCreateOrderLeavesNothingBehind(t *testing.T) {
+	db := openTestDB(t)
+	orders := &OrderServiceServer{DB: db}
+
+	customer := models.User{Name: "Customer", Email: "customer@example.com"}
+	item := models.Item{Name: "Mug", Price: models.NewMoney(1250, models.DefaultCurrency), StockOnHand: 1}
+	for _, record := range []interface{}{&customer, &item} {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+	}
+	ctx := ContextWithPrincipal(context.Background(), &Principal{Role: models.RoleAdmin})
+
+	// The stock reservation fails after the order, its items and the user link were inserted
+	_, err := orders.CreateOrder(ctx, &pb.CreateOrderRequest{Order: &pb.Order{
+		UserId: customer.ID,
+		Items:  []*pb.OrderItem{{ItemId: item.ID, Quantity: 2}},
+	}})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("CreateOrder: got %v, want resource exhausted", err)
+	}
+
+	for _, model := range []interface{}{&models.Order{}, &models.OrderItem{}, &models.UserOrder{}, &models.StockMovement{}, &models.OutboxEvent{}} {
+		var count int64
+		if err := db.Model(model).Count(&count).Error; err != nil {
+			t.Fatalf("count %T: %v", model, err)
+		}
+		if count != 0 {
+			t.Errorf("%d %T rows left behind, want none", count, model)
+		}
+	}
+	var stored models.Item
+	if err := db.First(&stored, item.ID).Error; err != nil {
+		t.Fatalf("fetch item: %v", err)
+	}
+	if stored.StockOnHand != 1 || stored.StockReserved != 0 {
+		t.Errorf("item has %d on hand and %d reserved, want 1 and 0", stored.StockOnHand, stored.StockReserved)
+	}
+}
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// transitionOrder moves the order to target inside tx. Every status change goes through here so the
+// lifecycle table in models.OrderStatus is enforced in one place and each change lands in the history.
+func transitionOrder(tx *gorm.DB, order *models.Order, target models.OrderStatus, reason string) error {
+	// Reject moves the lifecycle does not allow
+	if !order.Status.CanTransitionTo(target) {
+		return status.Errorf(codes.FailedPrecondition, "Order cannot move from '%s' to '%s'", order.Status, target)
+	}
+
+	previous := order.Status
+
+	// Only orders whose payment is secured are confirmed, and only orders whose payments were given back
+	// are cancelled
+	if target == models.OrderStatusConfirmed {
+		if err := requirePaymentAuthorization(tx, order); err != nil {
+			return err
+		}
+	}
+	if target == models.OrderStatusCancelled {
+		if err := requireSettledPayments(tx, order); err != nil {
+			return err
+		}
+	}
+
+	// Reserve, commit or release stock as the move requires
+	if err := applyOrderStockTransition(tx, order, target); err != nil {
+		return err
+	}
+
+	// A cancelled order gives its coupon redemption back
+	if target == models.OrderStatusCancelled && order.CouponID != nil {
+		if err := releaseCoupon(tx, order.ID, *order.CouponID); err != nil {
+			return err
+		}
+	}
+
+	// Persist the new status
+	if err := tx.Model(&models.Order{}).Where("id = ?", order.ID).Update("status", target).Error; err != nil {
+		log.Println("Error updating order status:", err)
+		return status.Errorf(codes.Internal, "Failed to update order status")
+	}
+	order.Status = target
+
+	// Record the change in the status history
+	history := models.OrderStatusHistory{
+		OrderID:    order.ID,
+		FromStatus: previous,
+		ToStatus:   target,
+		Reason:     reason,
+	}
+	if err := tx.Create(&history).Error; err != nil {
+		log.Println("Error inserting order status history:", err)
+		return status.Errorf(codes.Internal, "Failed to record order status history")
+	}
+
+	// Tell subscribers about the change
+	return recordOrderEvent(tx, models.OrderStatusEvent(target), order.ID, previous)
+}
+
+// cancelOrder gives the customer's money back, voiding authorized payments and refunding captured ones, and
+// then cancels the order, running also in the same transaction when given. The provider is asked outside
+// any transaction, so its answers are kept even when the cancellation fails; the order is only cancelled
+// once every payment was settled. Customers only cancel their own orders until they are confirmed.
+func (s *OrderServiceServer) cancelOrder(ctx context.Context, orderID int32, reason string, also func(tx *gorm.DB, order *models.Order) error) (order models.Order, previous models.OrderStatus, err error) {
+	if err := authorizeOrder(ctx, s.DB, models.PermOrdersWrite, orderID); err != nil {
+		return order, previous, err
+	}
+	check := func(order *models.Order) error { return authorizeCancel(ctx, order) }
+	if s.Payments != nil {
+		if err := s.Payments.settleOrderPayments(ctx, orderID, check); err != nil {
+			return order, previous, err
+		}
+	}
+
+	// Cancel the order; a payment authorized meanwhile keeps it from being cancelled
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if order, err = lockOrder(tx, orderID); err != nil {
+			return err
+		}
+		previous = order.Status
+		if err := check(&order); err != nil {
+			return err
+		}
+		if err := transitionOrder(tx, &order, models.OrderStatusCancelled, reason); err != nil {
+			return err
+		}
+		if also != nil {
+			return also(tx, &order)
+		}
+		return nil
+	})
+	return order, previous, err
+}
+
+// TransitionOrder moves an order to the requested lifecycle status, rejecting illegal moves with FailedPrecondition.
+// Statuses that follow from shipments and returns are refused; FulfillmentService and ReturnService set them.
+func (s *OrderServiceServer) TransitionOrder(ctx context.Context, req *pb.TransitionOrderRequest) (*pb.TransitionOrderResponse, error) {
+	// Validate the requested target status
+	target, ok := models.OrderStatusFromPb(req.GetTargetStatus())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "A valid target status is required")
+	}
+	// Shipping, delivery and returns follow from their records in the fulfillment and return services
+	if target.IsRecorded() {
+		return nil, status.Errorf(codes.FailedPrecondition, "Orders become '%s' by recording shipments and returns, not by moving them", target)
+	}
+
+	var order models.Order
+	var previous models.OrderStatus
+	var err error
+	if target == models.OrderStatusCancelled {
+		// Cancelling gives the customer's money back first
+		order, previous, err = s.cancelOrder(ctx, req.GetOrderId(), req.GetReason(), nil)
+	} else {
+		// Only staff and other systems move orders along otherwise
+		if err := authorizeAll(ctx, models.PermOrdersWrite); err != nil {
+			return nil, err
+		}
+
+		// Write the status and its history together
+		err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var err error
+			if order, err = lockOrder(tx, req.GetOrderId()); err != nil {
+				return err
+			}
+			previous = order.Status
+			return transitionOrder(tx, &order, target, req.GetReason())
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TransitionOrderResponse{
+		OrderId:        order.ID,
+		PreviousStatus: previous.ToPb(),
+		CurrentStatus:  order.Status.ToPb(),
+		Message:        fmt.Sprintf("Order moved from '%s' to '%s'", previous, order.Status),
+	}, nil
+}
@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+// PageTokenCodec issues and verifies page tokens. Tokens are opaque to clients and carry an HMAC so a
+// modified token, or a token reused with different filters, is rejected.
+type PageTokenCodec struct {
+	secret []byte
+}
+
+// NewPageTokenCodec creates a codec signing tokens with secret
+func NewPageTokenCodec(secret []byte) *PageTokenCodec {
+	return &PageTokenCodec{secret: secret}
+}
+
+// pageCursor is the keyset position stored in a page token
+type pageCursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        int32     `json:"i"`
+	Filter    string    `json:"f"` // Fingerprint of the filters and sort order the token was issued for
+}
+
+func (c *PageTokenCodec) sign(payload string) string {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// encode turns a cursor into a signed token
+func (c *PageTokenCodec) encode(cursor pageCursor) (string, error) {
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(raw)
+	return payload + "." + c.sign(payload), nil
+}
+
+// decode verifies a token and checks it was issued for the same filters
+func (c *PageTokenCodec) decode(token string, filter string) (*pageCursor, error) {
+	payload, signature, found := strings.Cut(token, ".")
+	if !found || !hmac.Equal([]byte(signature), []byte(c.sign(payload))) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid page token")
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid page token")
+	}
+	var cursor pageCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid page token")
+	}
+
+	if cursor.Filter != filter {
+		return nil, status.Errorf(codes.InvalidArgument, "Page token does not match the request filters")
+	}
+	return &cursor, nil
+}
+
+// pageRequest holds the paging fields shared by all list requests
+type pageRequest struct {
+	PageSize  int32
+	PageToken string
+	OrderBy   string
+}
+
+// resolvePageSize applies the default and the upper bound to a requested page size
+func resolvePageSize(requested int32) (int, error) {
+	switch {
+	case requested < 0:
+		return 0, status.Errorf(codes.InvalidArgument, "Page size cannot be negative")
+	case requested == 0:
+		return defaultPageSize, nil
+	case requested > maxPageSize:
+		return maxPageSize, nil
+	}
+	return int(requested), nil
+}
+
+// parseOrderBy reports whether a list should be sorted newest first
+func parseOrderBy(orderBy string) (bool, error) {
+	switch strings.Join(strings.Fields(strings.ToLower(orderBy)), " ") {
+	case "", "created_at", "created_at asc":
+		return false, nil
+	case "created_at desc":
+		return true, nil
+	}
+	return false, status.Errorf(codes.InvalidArgument, "Unsupported order_by %q", orderBy)
+}
+
+// filterFingerprint hashes a list request without its paging fields, so tokens stay valid across pages
+// but not across different filters
+func filterFingerprint(req proto.Message) (string, error) {
+	return messageFingerprint("", req, "page_size", "page_token")
+}
+
+// applyCreatedAtOrder sorts query by created_at and id, continuing after cursor when given
+func applyCreatedAtOrder(query *gorm.DB, cursor *pageCursor, desc bool) *gorm.DB {
+	direction := "ASC"
+	comparison := ">"
+	if desc {
+		direction = "DESC"
+		comparison = "<"
+	}
+	if cursor != nil {
+		query = query.Where("(created_at, id) "+comparison+" (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+	return query.Order("created_at " + direction).Order("id " + direction)
+}
+
+// listPage loads one page of T from query, sorted by created_at and id. It returns the rows and the token
+// for the next page, which is empty on the last page. Errors are gRPC status errors.
+func listPage[T any](query *gorm.DB, codec *PageTokenCodec, req proto.Message, page pageRequest, cursorOf func(*T) (time.Time, int32)) ([]T, string, error) {
+	size, err := resolvePageSize(page.PageSize)
+	if err != nil {
+		return nil, "", err
+	}
+	desc, err := parseOrderBy(page.OrderBy)
+	if err != nil {
+		return nil, "", err
+	}
+	filter, err := filterFingerprint(req)
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "Failed to read request filters")
+	}
+
+	// Continue after the position in the page token, if any
+	var cursor *pageCursor
+	if page.PageToken != "" {
+		if cursor, err = codec.decode(page.PageToken, filter); err != nil {
+			return nil, "", err
+		}
+	}
+
+	// Fetch one row more than requested to learn whether another page follows
+	var rows []T
+	if err := applyCreatedAtOrder(query, cursor, desc).Limit(size + 1).Find(&rows).Error; err != nil {
+		log.Println("Error fetching page:", err)
+		return nil, "", status.Errorf(codes.Internal, "Unable to fetch data")
+	}
+	if len(rows) <= size {
+		return rows, "", nil
+	}
+
+	rows = rows[:size]
+	createdAt, id := cursorOf(&rows[size-1])
+	next, err := codec.encode(pageCursor{CreatedAt: createdAt, ID: id, Filter: filter})
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "Failed to create page token")
+	}
+	return rows, next, nil
+}
+
+// streamPages calls sendPage for consecutive pages, starting at pageToken, until the last page has been
+// sent or the client has gone away. sendPage returns the token of the following page. Memory stays bounded
+// by one page, and stream.Send blocking on flow control naturally throttles the database reads.
+func streamPages(ctx context.Context, pageToken string, sendPage func(pageToken string) (string, error)) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		next, err := sendPage(pageToken)
+		if err != nil {
+			return err
+		}
+		if next == "" {
+			return nil
+		}
+		pageToken = next
+	}
+}
+
+// parseTimeFilter parses an optional RFC 3339 timestamp filter
+func parseTimeFilter(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s must be an RFC 3339 timestamp", name)
+	}
+	return &parsed, nil
+}
+
+// likePattern escapes LIKE wildcards in value and wraps it for a substring match
+func likePattern(value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+	return "%" + escaped + "%"
+}
@@ -0,0 +1,512 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DefaultPaymentTimeout bounds a call to the payment provider when none is configured
+const DefaultPaymentTimeout = 10 * time.Second
+
+// PaymentServiceServer implements the gRPC PaymentService
+type PaymentServiceServer struct {
+	pb.UnimplementedPaymentServiceServer
+	DB       *gorm.DB
+	Provider PaymentProvider
+	Timeout  time.Duration // Bounds every call to the provider; DefaultPaymentTimeout when zero
+}
+
+func (s *PaymentServiceServer) timeout() time.Duration {
+	if s.Timeout <= 0 {
+		return DefaultPaymentTimeout
+	}
+	return s.Timeout
+}
+
+// requirePaymentAuthorization fails unless the authorized or captured payments of order cover its final
+// price. Orders are only confirmed once their payment is secured.
+func requirePaymentAuthorization(tx *gorm.DB, order *models.Order) error {
+	// Lock the order so its payments cannot be voided meanwhile
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&models.Order{}, order.ID).Error; err != nil {
+		log.Println("Error locking order:", err)
+		return status.Errorf(codes.Internal, "Failed to fetch order")
+	}
+
+	// Payments being voided or refunded at the provider do not count
+	var payments []models.Payment
+	if err := tx.Where("order_id = ? AND status IN ?", order.ID, []models.PaymentStatus{models.PaymentStatusAuthorized, models.PaymentStatusCaptured}).
+		Where("NOT EXISTS (SELECT 1 FROM payment_attempts WHERE payment_attempts.payment_id = payments.id AND payment_attempts.result = ? AND payment_attempts.operation IN ?)",
+			models.PaymentAttemptPending, []models.PaymentOperation{models.PaymentOperationVoid, models.PaymentOperationRefund}).
+		Find(&payments).Error; err != nil {
+		log.Println("Error fetching payments:", err)
+		return status.Errorf(codes.Internal, "Failed to fetch payments")
+	}
+	var covered int64
+	for _, payment := range payments {
+		if payment.Amount.Currency != order.FinalPrice.Currency {
+			continue
+		}
+		if payment.Status == models.PaymentStatusCaptured {
+			covered += payment.CapturedAmount.MinorUnits
+		} else {
+			covered += payment.Amount.MinorUnits
+		}
+	}
+	if covered < order.FinalPrice.MinorUnits {
+		return status.Errorf(codes.FailedPrecondition, "Order %d needs an authorized payment of %s before it can be confirmed", order.ID, order.FinalPrice)
+	}
+	return nil
+}
+
+// loadPayment fetches a payment with its attempts
+func loadPayment(db *gorm.DB, paymentID int32) (models.Payment, error) {
+	var payment models.Payment
+	if err := db.Preload("Attempts", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id")
+	}).First(&payment, paymentID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return payment, status.Errorf(codes.NotFound, "Payment not found")
+		}
+		return payment, status.Errorf(codes.Internal, "Failed to fetch payment: %v", err)
+	}
+	return payment, nil
+}
+
+// movePayment moves a payment to target, writing the given extra columns with the status
+func movePayment(tx *gorm.DB, payment *models.Payment, target models.PaymentStatus, columns map[string]interface{}) error {
+	if !payment.Status.CanTransitionTo(target) {
+		return status.Errorf(codes.FailedPrecondition, "Payment cannot move from '%s' to '%s'", payment.Status, target)
+	}
+
+	columns["status"] = target
+	if err := tx.Model(&models.Payment{}).Where("id = ?", payment.ID).Updates(columns).Error; err != nil {
+		log.Println("Error updating payment:", err)
+		return status.Errorf(codes.Internal, "Failed to update payment")
+	}
+	payment.Status = target
+	return nil
+}
+
+// beginOperation records operation on amount of payment as a pending attempt inside tx, before the provider
+// is asked, so the operation stays known when its answer is lost. tx must hold the payment's row lock. One
+// operation of a payment is in flight at a time; pending attempts that got no answer within twice the
+// timeout never will, and are failed.
+func (s *PaymentServiceServer) beginOperation(tx *gorm.DB, payment *models.Payment, operation models.PaymentOperation, amount models.Money) (models.PaymentAttempt, error) {
+	attempt := models.PaymentAttempt{PaymentID: payment.ID, Operation: operation, Result: models.PaymentAttemptPending, Amount: amount}
+	if err := tx.Model(&models.PaymentAttempt{}).Where("payment_id = ? AND result = ? AND created_at <= ?", payment.ID, models.PaymentAttemptPending, time.Now().Add(-2*s.timeout())).
+		Updates(map[string]interface{}{"result": models.PaymentAttemptFailed, "error": "No answer was recorded"}).Error; err != nil {
+		log.Println("Error updating payment attempts:", err)
+		return attempt, status.Errorf(codes.Internal, "Failed to update payment attempts")
+	}
+	var inFlight int64
+	if err := tx.Model(&models.PaymentAttempt{}).Where("payment_id = ? AND result = ?", payment.ID, models.PaymentAttemptPending).Count(&inFlight).Error; err != nil {
+		log.Println("Error counting payment attempts:", err)
+		return attempt, status.Errorf(codes.Internal, "Failed to fetch payment attempts")
+	}
+	if inFlight > 0 {
+		return attempt, status.Errorf(codes.Aborted, "Payment %d has an operation in flight; try again shortly", payment.ID)
+	}
+
+	if err := tx.Create(&attempt).Error; err != nil {
+		log.Println("Error inserting payment attempt:", err)
+		return attempt, status.Errorf(codes.Internal, "Failed to record payment attempt")
+	}
+	return attempt, nil
+}
+
+// askProvider sends the operation of a pending attempt of payment to the provider within the payment
+// timeout. It runs outside any transaction; method is only used to authorize.
+func (s *PaymentServiceServer) askProvider(ctx context.Context, payment *models.Payment, attempt *models.PaymentAttempt, method string) (PaymentResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout())
+	defer cancel()
+
+	switch attempt.Operation {
+	case models.PaymentOperationAuthorize:
+		return s.Provider.Authorize(ctx, PaymentRequest{
+			OrderID:        payment.OrderID,
+			Amount:         attempt.Amount,
+			PaymentMethod:  method,
+			IdempotencyKey: fmt.Sprintf("payment-%d", payment.ID),
+		})
+	case models.PaymentOperationCapture:
+		return s.Provider.Capture(ctx, payment.Reference, attempt.Amount)
+	case models.PaymentOperationVoid:
+		return s.Provider.Void(ctx, payment.Reference)
+	case models.PaymentOperationRefund:
+		return s.Provider.Refund(ctx, payment.Reference, attempt.Amount)
+	}
+	return PaymentResult{}, fmt.Errorf("unknown payment operation %q", attempt.Operation)
+}
+
+// operationOutcome returns the status payment moves to once the provider answered attempt with result, and
+// the columns written with it. The status is empty when the payment stays as it is.
+func operationOutcome(payment *models.Payment, attempt *models.PaymentAttempt, result PaymentResult) (models.PaymentStatus, map[string]interface{}) {
+	if attempt.Operation == models.PaymentOperationAuthorize {
+		target := models.PaymentStatusAuthorized
+		switch attempt.Result {
+		case models.PaymentAttemptDeclined:
+			target = models.PaymentStatusDeclined
+		case models.PaymentAttemptFailed:
+			target = models.PaymentStatusFailed
+		}
+		return target, map[string]interface{}{"reference": result.Reference, "failure_reason": attempt.Error}
+	}
+	if attempt.Result != models.PaymentAttemptSucceeded {
+		return "", nil
+	}
+
+	switch attempt.Operation {
+	case models.PaymentOperationCapture:
+		return models.PaymentStatusCaptured, map[string]interface{}{
+			"captured_minor_units": attempt.Amount.MinorUnits,
+			"captured_currency":    attempt.Amount.Currency,
+		}
+	case models.PaymentOperationVoid:
+		return models.PaymentStatusVoided, map[string]interface{}{}
+	case models.PaymentOperationRefund:
+		refunded := payment.RefundedAmount.MinorUnits + attempt.Amount.MinorUnits
+		target := models.PaymentStatusPartiallyRefunded
+		if refunded >= payment.CapturedAmount.MinorUnits {
+			target = models.PaymentStatusRefunded
+		}
+		return target, map[string]interface{}{
+			"refunded_minor_units": refunded,
+			"refunded_currency":    attempt.Amount.Currency,
+		}
+	}
+	return "", nil
+}
+
+// finishOperation records the provider's answer to a pending attempt of payment and applies it, in a
+// transaction of its own that outlives a cancelled request, so the answer is kept whatever happens next
+func (s *PaymentServiceServer) finishOperation(ctx context.Context, payment *models.Payment, attempt *models.PaymentAttempt, result PaymentResult, callErr error) error {
+	attempt.Result, attempt.Error = models.PaymentAttemptSucceeded, ""
+	switch {
+	case callErr != nil:
+		log.Printf("Payment provider %s failed to %s payment %d: %v", s.Provider.Name(), attempt.Operation, payment.ID, callErr)
+		attempt.Result, attempt.Error = models.PaymentAttemptFailed, callErr.Error()
+	case !result.Approved:
+		attempt.Result, attempt.Error = models.PaymentAttemptDeclined, result.DeclineReason
+	}
+
+	return s.DB.WithContext(context.WithoutCancel(ctx)).Transaction(func(tx *gorm.DB) error {
+		// Record the answer
+		if err := tx.Model(&models.PaymentAttempt{}).Where("id = ?", attempt.ID).
+			Updates(map[string]interface{}{"result": attempt.Result, "error": attempt.Error}).Error; err != nil {
+			log.Println("Error updating payment attempt:", err)
+			return status.Errorf(codes.Internal, "Failed to record payment attempt")
+		}
+
+		// Apply it to the payment as it is now
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(payment, payment.ID).Error; err != nil {
+			log.Println("Error locking payment:", err)
+			return status.Errorf(codes.Internal, "Failed to fetch payment")
+		}
+		target, columns := operationOutcome(payment, attempt, result)
+		if target == "" {
+			return nil
+		}
+		if !payment.Status.CanTransitionTo(target) {
+			log.Printf("Payment %d is '%s'; the answer to %s it was recorded but not applied", payment.ID, payment.Status, attempt.Operation)
+			return nil
+		}
+		return movePayment(tx, payment, target, columns)
+	})
+}
+
+// runOperation asks the provider to carry out a pending attempt of payment, then records and applies the
+// answer. attempt holds the answer afterwards.
+func (s *PaymentServiceServer) runOperation(ctx context.Context, payment *models.Payment, attempt *models.PaymentAttempt, method string) error {
+	result, err := s.askProvider(ctx, payment, attempt, method)
+	return s.finishOperation(ctx, payment, attempt, result, err)
+}
+
+// changePayment runs one operation on a payment in three steps, so no answer of the provider is lost to a
+// rollback: begin checks the locked order and payment and picks the operation, which is committed as a
+// pending attempt; the provider is asked outside any transaction; its answer is applied in a transaction
+// of its own. It returns the updated payment with its attempts.
+func (s *PaymentServiceServer) changePayment(ctx context.Context, paymentID int32, begin func(order *models.Order, payment *models.Payment) (models.PaymentOperation, models.Money, error)) (*pb.Payment, error) {
+	var payment models.Payment
+	var attempt models.PaymentAttempt
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		found, err := loadPayment(tx, paymentID)
+		if err != nil {
+			return err
+		}
+
+		// Lock the order first, as confirming it does, then the payment
+		order, err := lockOrder(tx, found.OrderID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&payment, paymentID).Error; err != nil {
+			log.Println("Error locking payment:", err)
+			return status.Errorf(codes.Internal, "Failed to fetch payment")
+		}
+		operation, amount, err := begin(&order, &payment)
+		if err != nil {
+			return err
+		}
+		attempt, err = s.beginOperation(tx, &payment, operation, amount)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.runOperation(ctx, &payment, &attempt, ""); err != nil {
+		return nil, err
+	}
+
+	updated, err := loadPayment(s.DB.WithContext(ctx), paymentID)
+	if err != nil {
+		return nil, err
+	}
+	return updated.ToPb(), nil
+}
+
+// paymentAmount returns the requested amount of an operation, or fallback when none was given, and
+// checks it is positive, in the payment's currency and at most fallback
+func paymentAmount(requested *pb.Money, fallback models.Money, operation models.PaymentOperation) (models.Money, error) {
+	if requested == nil {
+		return fallback, nil
+	}
+	amount, err := models.MoneyFromPb(requested)
+	if err != nil {
+		return amount, status.Errorf(codes.InvalidArgument, "Invalid amount: %v", err)
+	}
+	if amount.Currency != fallback.Currency {
+		return amount, status.Errorf(codes.InvalidArgument, "The payment is in %s; cannot %s %s", fallback.Currency, operation, amount.Currency)
+	}
+	if amount.MinorUnits <= 0 || amount.MinorUnits > fallback.MinorUnits {
+		return amount, status.Errorf(codes.InvalidArgument, "Amount to %s must be positive and at most %s", operation, fallback)
+	}
+	return amount, nil
+}
+
+// AuthorizePayment authorizes the final price of a draft or pending order with the provider. A declined or
+// timed out authorization is returned as the payment with that outcome.
+func (s *PaymentServiceServer) AuthorizePayment(ctx context.Context, req *pb.AuthorizePaymentRequest) (*pb.Payment, error) {
+	return s.authorizePayment(ctx, req.GetOrderId(), req.GetPaymentMethod(), nil)
+}
+
+// authorizePayment authorizes the final price of an order with method. The payment is tagged with
+// checkoutID before the provider is asked, so a checkout can tell its own payments from others.
+func (s *PaymentServiceServer) authorizePayment(ctx context.Context, orderID int32, method string, checkoutID *int32) (*pb.Payment, error) {
+	method = strings.TrimSpace(method)
+	if method == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "A payment method is required")
+	}
+
+	// Record the payment as pending before asking the provider, so no authorization goes untracked
+	var payment models.Payment
+	var attempt models.PaymentAttempt
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		order, err := lockOrder(tx, orderID)
+		if err != nil {
+			return err
+		}
+		if !order.Status.IsEditable() {
+			return status.Errorf(codes.FailedPrecondition, "Only draft and pending orders can be paid for; order is '%s'", order.Status)
+		}
+		if order.FinalPrice.MinorUnits <= 0 {
+			return status.Errorf(codes.FailedPrecondition, "Order %d has nothing to pay", order.ID)
+		}
+
+		// One payment at a time; pending payments older than the timeout got no answer and do not count
+		var open int64
+		if err := tx.Model(&models.Payment{}).Where("order_id = ?", order.ID).
+			Where("status IN ? OR (status = ? AND created_at > ?)", []models.PaymentStatus{models.PaymentStatusAuthorized, models.PaymentStatusCaptured},
+				models.PaymentStatusPending, time.Now().Add(-s.timeout())).
+			Count(&open).Error; err != nil {
+			log.Println("Error counting payments:", err)
+			return status.Errorf(codes.Internal, "Failed to fetch payments")
+		}
+		if open > 0 {
+			return status.Errorf(codes.FailedPrecondition, "Order %d already has a payment; void it before authorizing another", order.ID)
+		}
+
+		currency := order.FinalPrice.Currency
+		payment = models.Payment{
+			OrderID:        order.ID,
+			Provider:       s.Provider.Name(),
+			Status:         models.PaymentStatusPending,
+			Amount:         order.FinalPrice,
+			CapturedAmount: models.NewMoney(0, currency),
+			RefundedAmount: models.NewMoney(0, currency),
+			CheckoutID:     checkoutID,
+		}
+		if err := tx.Create(&payment).Error; err != nil {
+			log.Println("Error inserting payment:", err)
+			return status.Errorf(codes.Internal, "Failed to insert payment")
+		}
+		attempt, err = s.beginOperation(tx, &payment, models.PaymentOperationAuthorize, payment.Amount)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Ask the provider and record its answer
+	if err := s.runOperation(ctx, &payment, &attempt, method); err != nil {
+		return nil, err
+	}
+
+	return s.GetPayment(ctx, &pb.GetPaymentRequest{PaymentId: payment.ID})
+}
+
+// CapturePayment takes the authorized amount, or part of it, from the customer
+func (s *PaymentServiceServer) CapturePayment(ctx context.Context, req *pb.CapturePaymentRequest) (*pb.Payment, error) {
+	return s.changePayment(ctx, req.GetPaymentId(), func(order *models.Order, payment *models.Payment) (models.PaymentOperation, models.Money, error) {
+		if !payment.Status.CanTransitionTo(models.PaymentStatusCaptured) {
+			return "", models.Money{}, status.Errorf(codes.FailedPrecondition, "Payments in status '%s' cannot be captured", payment.Status)
+		}
+		amount, err := paymentAmount(req.GetAmount(), payment.Amount, models.PaymentOperationCapture)
+		return models.PaymentOperationCapture, amount, err
+	})
+}
+
+// VoidPayment releases an authorization that was not captured. Payments of confirmed orders stay until
+// the order is cancelled.
+func (s *PaymentServiceServer) VoidPayment(ctx context.Context, req *pb.VoidPaymentRequest) (*pb.Payment, error) {
+	return s.changePayment(ctx, req.GetPaymentId(), func(order *models.Order, payment *models.Payment) (models.PaymentOperation, models.Money, error) {
+		if !payment.Status.CanTransitionTo(models.PaymentStatusVoided) {
+			return "", models.Money{}, status.Errorf(codes.FailedPrecondition, "Payments in status '%s' cannot be voided", payment.Status)
+		}
+		if !order.Status.IsEditable() && order.Status != models.OrderStatusCancelled {
+			return "", models.Money{}, status.Errorf(codes.FailedPrecondition, "The payment secures order %d, which is '%s'; cancel the order first", order.ID, order.Status)
+		}
+		return models.PaymentOperationVoid, payment.Amount, nil
+	})
+}
+
+// RefundPayment gives back captured money, by default all of it that was not refunded yet
+func (s *PaymentServiceServer) RefundPayment(ctx context.Context, req *pb.RefundPaymentRequest) (*pb.Payment, error) {
+	return s.changePayment(ctx, req.GetPaymentId(), func(order *models.Order, payment *models.Payment) (models.PaymentOperation, models.Money, error) {
+		if !payment.Status.CanTransitionTo(models.PaymentStatusRefunded) {
+			return "", models.Money{}, status.Errorf(codes.FailedPrecondition, "Payments in status '%s' cannot be refunded", payment.Status)
+		}
+		amount, err := paymentAmount(req.GetAmount(), payment.Refundable(), models.PaymentOperationRefund)
+		return models.PaymentOperationRefund, amount, err
+	})
+}
+
+// openPaymentStatuses are the statuses of payments that hold or have kept the customer's money
+var openPaymentStatuses = []models.PaymentStatus{models.PaymentStatusAuthorized, models.PaymentStatusCaptured, models.PaymentStatusPartiallyRefunded}
+
+// settleOrderPayments gives the customer's money back before an order is cancelled: authorized payments
+// are voided and captured ones refunded. Each operation is committed as a pending attempt before the
+// provider is asked and its answer applied on its own, so no answer is lost when the cancellation fails
+// later. It fails with FailedPrecondition unless the provider settled every payment. check, when given,
+// runs on the locked order first and stops the settlement by failing.
+func (s *PaymentServiceServer) settleOrderPayments(ctx context.Context, orderID int32, check func(order *models.Order) error) error {
+	// Record what each payment gives back
+	var payments []models.Payment
+	var attempts []models.PaymentAttempt
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Nothing is given back for an order that cannot be cancelled anyway
+		order, err := lockOrder(tx, orderID)
+		if err != nil {
+			return err
+		}
+		if !order.Status.CanTransitionTo(models.OrderStatusCancelled) {
+			return status.Errorf(codes.FailedPrecondition, "Order cannot move from '%s' to '%s'", order.Status, models.OrderStatusCancelled)
+		}
+		if check != nil {
+			if err := check(&order); err != nil {
+				return err
+			}
+		}
+
+		var open []models.Payment
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("order_id = ? AND status IN ?", orderID, openPaymentStatuses).
+			Order("id").Find(&open).Error; err != nil {
+			log.Println("Error fetching payments:", err)
+			return status.Errorf(codes.Internal, "Failed to fetch payments")
+		}
+		for i := range open {
+			payment := &open[i]
+			operation, amount := models.PaymentOperationVoid, payment.Amount
+			switch {
+			case payment.Status == models.PaymentStatusAuthorized:
+			case payment.Refundable().MinorUnits > 0:
+				operation, amount = models.PaymentOperationRefund, payment.Refundable()
+			default:
+				continue
+			}
+			attempt, err := s.beginOperation(tx, payment, operation, amount)
+			if err != nil {
+				return err
+			}
+			payments = append(payments, *payment)
+			attempts = append(attempts, attempt)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Ask the provider about every payment; each answer is kept
+	for i := range payments {
+		if err := s.runOperation(ctx, &payments[i], &attempts[i], ""); err != nil {
+			return err
+		}
+	}
+	for i := range attempts {
+		if attempts[i].Result != models.PaymentAttemptSucceeded {
+			return status.Errorf(codes.FailedPrecondition, "Payment %d of order %d could not be given back (%s); the order was not cancelled", payments[i].ID, orderID, attempts[i].Error)
+		}
+	}
+	return nil
+}
+
+// requireSettledPayments fails while an order still holds an authorization or captured money that was
+// not refunded, so no order is cancelled with the customer still charged
+func requireSettledPayments(tx *gorm.DB, order *models.Order) error {
+	var payments []models.Payment
+	if err := tx.Where("order_id = ? AND status IN ?", order.ID, openPaymentStatuses).Find(&payments).Error; err != nil {
+		log.Println("Error fetching payments:", err)
+		return status.Errorf(codes.Internal, "Failed to fetch payments")
+	}
+	for _, payment := range payments {
+		if payment.Status == models.PaymentStatusAuthorized || payment.Refundable().MinorUnits > 0 {
+			return status.Errorf(codes.FailedPrecondition, "Order %d still holds payment %d; void or refund it before cancelling the order", order.ID, payment.ID)
+		}
+	}
+	return nil
+}
+
+func (s *PaymentServiceServer) GetPayment(ctx context.Context, req *pb.GetPaymentRequest) (*pb.Payment, error) {
+	payment, err := loadPayment(s.DB.WithContext(ctx), req.GetPaymentId())
+	if err != nil {
+		return nil, err
+	}
+	return payment.ToPb(), nil
+}
+
+func (s *PaymentServiceServer) GetPaymentsByOrderId(ctx context.Context, req *pb.GetPaymentsByOrderIdRequest) (*pb.GetPaymentsResponse, error) {
+	var payments []models.Payment
+	if err := s.DB.WithContext(ctx).Preload("Attempts", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id")
+	}).Where("order_id = ?", req.GetOrderId()).Order("id").Find(&payments).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to fetch payments: %v", err)
+	}
+
+	response := &pb.GetPaymentsResponse{}
+	for i := range payments {
+		response.Payments = append(response.Payments, payments[i].ToPb())
+	}
+	return response, nil
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+)
+
+// PaymentRequest asks a provider to authorize an amount for an order
+type PaymentRequest struct {
+	OrderID        int32
+	Amount         models.Money
+	PaymentMethod  string // Provider-specific token for the card or account to charge
+	IdempotencyKey string // Identifies the payment, so a retried authorization is not charged twice
+}
+
+// PaymentResult is the answer of a provider to an operation it processed
+type PaymentResult struct {
+	Reference     string // Provider reference of the authorization, used for later operations
+	Approved      bool
+	DeclineReason string
+}
+
+// PaymentProvider is a payment gateway. Operations return an error only when the outcome is unknown, e.g.
+// on a timeout; a declined operation is a result with Approved false.
+type PaymentProvider interface {
+	Name() string
+	Authorize(ctx context.Context, request PaymentRequest) (PaymentResult, error)
+	Capture(ctx context.Context, reference string, amount models.Money) (PaymentResult, error)
+	Void(ctx context.Context, reference string) (PaymentResult, error)
+	Refund(ctx context.Context, reference string, amount models.Money) (PaymentResult, error)
+}
+
+// FakePaymentOutcome is how the fake provider answers
+type FakePaymentOutcome string
+
+const (
+	FakePaymentSucceed FakePaymentOutcome = "succeed"
+	FakePaymentDecline FakePaymentOutcome = "decline"
+	FakePaymentTimeout FakePaymentOutcome = "timeout" // Never answers; the caller's deadline runs out
+)
+
+// ParseFakePaymentOutcome validates a configured outcome
+func ParseFakePaymentOutcome(value string) (FakePaymentOutcome, error) {
+	switch outcome := FakePaymentOutcome(strings.ToLower(strings.TrimSpace(value))); outcome {
+	case FakePaymentSucceed, FakePaymentDecline, FakePaymentTimeout:
+		return outcome, nil
+	}
+	return "", fmt.Errorf("fake payment outcome must be %q, %q or %q", FakePaymentSucceed, FakePaymentDecline, FakePaymentTimeout)
+}
+
+// FakePaymentProvider is an in-process gateway for running end to end offline. Every operation on a
+// payment answers with Outcome, unless the payment method it was authorized with is "fake-succeed",
+// "fake-decline" or "fake-timeout", which picks the outcome for that payment.
+type FakePaymentProvider struct {
+	Outcome FakePaymentOutcome
+	nextID  atomic.Int64
+}
+
+func (p *FakePaymentProvider) Name() string {
+	return "fake"
+}
+
+// answer returns the result of an operation given the outcome picked for it
+func (p *FakePaymentProvider) answer(ctx context.Context, outcome FakePaymentOutcome, reference string) (PaymentResult, error) {
+	switch outcome {
+	case FakePaymentDecline:
+		return PaymentResult{Reference: reference, DeclineReason: "Declined by the fake payment provider"}, nil
+	case FakePaymentTimeout:
+		<-ctx.Done()
+		return PaymentResult{}, ctx.Err()
+	}
+	return PaymentResult{Reference: reference, Approved: true}, nil
+}
+
+// outcomeOf returns the outcome a payment method or reference picks, or else the configured one
+func (p *FakePaymentProvider) outcomeOf(value string) FakePaymentOutcome {
+	for _, outcome := range []FakePaymentOutcome{FakePaymentSucceed, FakePaymentDecline, FakePaymentTimeout} {
+		if strings.Contains(value, "fake-"+string(outcome)) {
+			return outcome
+		}
+	}
+	if p.Outcome == "" {
+		return FakePaymentSucceed
+	}
+	return p.Outcome
+}
+
+func (p *FakePaymentProvider) Authorize(ctx context.Context, request PaymentRequest) (PaymentResult, error) {
+	// The reference carries the outcome picked by the payment method to the operations that follow
+	reference := fmt.Sprintf("fake_%d_%d_fake-%s", time.Now().UnixNano(), p.nextID.Add(1), p.outcomeOf(request.PaymentMethod))
+	return p.answer(ctx, p.outcomeOf(reference), reference)
+}
+
+func (p *FakePaymentProvider) Capture(ctx context.Context, reference string, amount models.Money) (PaymentResult, error) {
+	return p.answer(ctx, p.outcomeOf(reference), reference)
+}
+
+func (p *FakePaymentProvider) Void(ctx context.Context, reference string) (PaymentResult, error) {
+	return p.answer(ctx, p.outcomeOf(reference), reference)
+}
+
+func (p *FakePaymentProvider) Refund(ctx context.Context, reference string, amount models.Money) (PaymentResult, error) {
+	return p.answer(ctx, p.outcomeOf(reference), reference)
+}
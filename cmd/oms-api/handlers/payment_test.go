@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPaymentAmount(t *testing.T) {
+	captured := models.NewMoney(2500, "USD")
+	for _, test := range []struct {
+		name      string
+		requested *pb.Money
+		want      models.Money
+		wantCode  codes.Code
+	}{
+		{"defaults to the whole amount", nil, captured, codes.OK},
+		{"part of it", &pb.Money{CurrencyCode: "USD", Units: 10}, models.NewMoney(1000, "USD"), codes.OK},
+		{"all of it", &pb.Money{CurrencyCode: "usd", Units: 25}, captured, codes.OK},
+		{"more than available", &pb.Money{CurrencyCode: "USD", Units: 25, Nanos: 10_000_000}, models.Money{}, codes.InvalidArgument},
+		{"zero", &pb.Money{CurrencyCode: "USD"}, models.Money{}, codes.InvalidArgument},
+		{"negative", &pb.Money{CurrencyCode: "USD", Units: -1}, models.Money{}, codes.InvalidArgument},
+		{"other currency", &pb.Money{CurrencyCode: "EUR", Units: 10}, models.Money{}, codes.InvalidArgument},
+		{"finer than a cent", &pb.Money{CurrencyCode: "USD", Nanos: 1_000}, models.Money{}, codes.InvalidArgument},
+	} {
+		got, err := paymentAmount(test.requested, captured, models.PaymentOperationRefund)
+		if code := status.Code(err); code != test.wantCode {
+			t.Errorf("%s: got %v, want %v", test.name, err, test.wantCode)
+			continue
+		}
+		if err == nil && got != test.want {
+			t.Errorf("%s: got %s, want %s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestOperationOutcome(t *testing.T) {
+	payment := &models.Payment{
+		Amount:         models.NewMoney(2500, "USD"),
+		CapturedAmount: models.NewMoney(2500, "USD"),
+		RefundedAmount: models.NewMoney(1000, "USD"),
+	}
+	reasons := map[models.PaymentAttemptResult]string{models.PaymentAttemptDeclined: "Insufficient funds", models.PaymentAttemptFailed: "Timed out"}
+	attempt := func(operation models.PaymentOperation, result models.PaymentAttemptResult, minorUnits int64) *models.PaymentAttempt {
+		return &models.PaymentAttempt{Operation: operation, Result: result, Amount: models.NewMoney(minorUnits, "USD"), Error: reasons[result]}
+	}
+	result := PaymentResult{Reference: "ref_1", Approved: true}
+
+	for _, test := range []struct {
+		name    string
+		attempt *models.PaymentAttempt
+		want    models.PaymentStatus
+		columns map[string]interface{}
+	}{
+		{"authorized", attempt(models.PaymentOperationAuthorize, models.PaymentAttemptSucceeded, 2500), models.PaymentStatusAuthorized,
+			map[string]interface{}{"reference": "ref_1", "failure_reason": ""}},
+		{"authorization declined", attempt(models.PaymentOperationAuthorize, models.PaymentAttemptDeclined, 2500), models.PaymentStatusDeclined,
+			map[string]interface{}{"reference": "ref_1", "failure_reason": "Insufficient funds"}},
+		{"authorization failed", attempt(models.PaymentOperationAuthorize, models.PaymentAttemptFailed, 2500), models.PaymentStatusFailed,
+			map[string]interface{}{"reference": "ref_1", "failure_reason": "Timed out"}},
+		{"captured", attempt(models.PaymentOperationCapture, models.PaymentAttemptSucceeded, 2000), models.PaymentStatusCaptured,
+			map[string]interface{}{"captured_minor_units": int64(2000), "captured_currency": "USD"}},
+		{"voided", attempt(models.PaymentOperationVoid, models.PaymentAttemptSucceeded, 2500), models.PaymentStatusVoided,
+			map[string]interface{}{}},
+		{"refunded in part", attempt(models.PaymentOperationRefund, models.PaymentAttemptSucceeded, 500), models.PaymentStatusPartiallyRefunded,
+			map[string]interface{}{"refunded_minor_units": int64(1500), "refunded_currency": "USD"}},
+		{"refunded in full", attempt(models.PaymentOperationRefund, models.PaymentAttemptSucceeded, 1500), models.PaymentStatusRefunded,
+			map[string]interface{}{"refunded_minor_units": int64(2500), "refunded_currency": "USD"}},
+		{"declined capture changes nothing", attempt(models.PaymentOperationCapture, models.PaymentAttemptDeclined, 2500), "", nil},
+		{"failed refund changes nothing", attempt(models.PaymentOperationRefund, models.PaymentAttemptFailed, 500), "", nil},
+	} {
+		target, columns := operationOutcome(payment, test.attempt, result)
+		if target != test.want || !reflect.DeepEqual(columns, test.columns) {
+			t.Errorf("%s: got %q %v, want %q %v", test.name, target, columns, test.want, test.columns)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// methodPermissions lists for every RPC the permissions that allow calling it; any one of them is enough.
+// An empty list lets every authenticated caller in. Methods granted by an ".own" permission check in the
+// handler that the caller owns the user or order. Methods missing from the map are denied.
+var methodPermissions = map[string][]models.Permission{
+	pb.AuthService_Logout_FullMethodName: {},
+
+	pb.OmsItemService_CreateItem_FullMethodName:      {models.PermItemsWrite},
+	pb.OmsItemService_GetItemById_FullMethodName:     {models.PermItemsRead},
+	pb.OmsItemService_GetAllItems_FullMethodName:     {models.PermItemsRead},
+	pb.OmsItemService_StreamItems_FullMethodName:     {models.PermItemsRead},
+	pb.OmsItemService_UpdateItemById_FullMethodName:  {models.PermItemsWrite},
+	pb.OmsItemService_DeleteItemById_FullMethodName:  {models.PermItemsWrite},
+	pb.OmsItemService_SetItemPrice_FullMethodName:    {models.PermItemsWrite},
+	pb.OmsItemService_DeleteItemPrice_FullMethodName: {models.PermItemsWrite},
+	pb.OmsItemService_GetStock_FullMethodName:        {models.PermItemsRead},
+	pb.OmsItemService_AdjustStock_FullMethodName:     {models.PermStockWrite},
+
+	pb.UserService_GetUserById_FullMethodName:           {models.PermUsersReadOwn},
+	pb.UserService_GetAllUsers_FullMethodName:           {models.PermUsersRead},
+	pb.UserService_StreamUsers_FullMethodName:           {models.PermUsersRead},
+	pb.UserService_UpdateUserById_FullMethodName:        {models.PermUsersWriteOwn},
+	pb.UserService_DeleteUserById_FullMethodName:        {models.PermUsersWrite},
+	pb.UserService_GetUserOrdersByUserId_FullMethodName: {models.PermOrdersReadOwn},
+	pb.UserService_CreateAddress_FullMethodName:         {models.PermUsersWriteOwn},
+	pb.UserService_GetAddressesByUserId_FullMethodName:  {models.PermUsersReadOwn},
+	pb.UserService_UpdateAddress_FullMethodName:         {models.PermUsersWriteOwn},
+	pb.UserService_DeleteAddress_FullMethodName:         {models.PermUsersWriteOwn},
+	pb.UserService_SetUserRole_FullMethodName:           {models.PermRolesManage},
+
+	pb.OrderService_CreateOrder_FullMethodName:                {models.PermOrdersWriteOwn},
+	pb.OrderService_QuoteOrder_FullMethodName:                 {models.PermOrdersWriteOwn},
+	pb.OrderService_GetOrderById_FullMethodName:               {models.PermOrdersReadOwn},
+	pb.OrderService_GetAllOrders_FullMethodName:               {models.PermOrdersRead},
+	pb.OrderService_StreamOrders_FullMethodName:               {models.PermOrdersRead},
+	pb.OrderService_UpdateOrderById_FullMethodName:            {models.PermOrdersWriteOwn},
+	pb.OrderService_DeleteOrderById_FullMethodName:            {models.PermOrdersWriteOwn},
+	pb.OrderService_UpdateOrderStatusByOrderId_FullMethodName: {models.PermOrdersWrite},
+	pb.OrderService_TransitionOrder_FullMethodName:            {models.PermOrdersWriteOwn},
+	pb.OrderService_Checkout_FullMethodName:                   {models.PermOrdersWriteOwn},
+	pb.OrderService_GetCheckout_FullMethodName:                {models.PermOrdersReadOwn},
+
+	pb.DiscountService_CreateDiscountRule_FullMethodName:     {models.PermPricingWrite},
+	pb.DiscountService_GetDiscountRuleById_FullMethodName:    {models.PermPricingRead},
+	pb.DiscountService_GetAllDiscountRules_FullMethodName:    {models.PermPricingRead},
+	pb.DiscountService_UpdateDiscountRuleById_FullMethodName: {models.PermPricingWrite},
+	pb.DiscountService_DeleteDiscountRuleById_FullMethodName: {models.PermPricingWrite},
+	pb.DiscountService_CreateCoupon_FullMethodName:           {models.PermPricingWrite},
+	pb.DiscountService_GetCoupon_FullMethodName:              {models.PermPricingRead},
+	pb.DiscountService_GetAllCoupons_FullMethodName:          {models.PermPricingRead},
+	pb.DiscountService_DeleteCoupon_FullMethodName:           {models.PermPricingWrite},
+
+	pb.CurrencyService_SetExchangeRate_FullMethodName:     {models.PermPricingWrite},
+	pb.CurrencyService_GetAllExchangeRates_FullMethodName: {models.PermPricingRead},
+	pb.CurrencyService_ImportExchangeRates_FullMethodName: {models.PermPricingWrite},
+	pb.CurrencyService_DeleteExchangeRate_FullMethodName:  {models.PermPricingWrite},
+
+	pb.TaxService_SetTaxJurisdiction_FullMethodName:     {models.PermPricingWrite},
+	pb.TaxService_GetAllTaxJurisdictions_FullMethodName: {models.PermPricingRead},
+	pb.TaxService_DeleteTaxJurisdiction_FullMethodName:  {models.PermPricingWrite},
+	pb.TaxService_SetTaxRate_FullMethodName:             {models.PermPricingWrite},
+	pb.TaxService_DeleteTaxRate_FullMethodName:          {models.PermPricingWrite},
+
+	pb.FulfillmentService_CreateShipment_FullMethodName:        {models.PermFulfillmentWrite},
+	pb.FulfillmentService_MarkShipmentDelivered_FullMethodName: {models.PermFulfillmentWrite},
+	pb.FulfillmentService_GetShipment_FullMethodName:           {models.PermOrdersRead},
+	pb.FulfillmentService_GetShipmentsByOrderId_FullMethodName: {models.PermOrdersRead},
+
+	pb.ReturnService_RequestReturn_FullMethodName:       {models.PermReturnsWriteOwn},
+	pb.ReturnService_ApproveReturn_FullMethodName:       {models.PermReturnsWrite},
+	pb.ReturnService_RejectReturn_FullMethodName:        {models.PermReturnsWrite},
+	pb.ReturnService_ReceiveReturn_FullMethodName:       {models.PermReturnsWrite},
+	pb.ReturnService_RefundReturn_FullMethodName:        {models.PermReturnsWrite},
+	pb.ReturnService_GetReturn_FullMethodName:           {models.PermOrdersRead},
+	pb.ReturnService_GetReturnsByOrderId_FullMethodName: {models.PermOrdersRead},
+
+	pb.PaymentService_AuthorizePayment_FullMethodName:     {models.PermPaymentsWrite},
+	pb.PaymentService_CapturePayment_FullMethodName:       {models.PermPaymentsWrite},
+	pb.PaymentService_VoidPayment_FullMethodName:          {models.PermPaymentsWrite},
+	pb.PaymentService_RefundPayment_FullMethodName:        {models.PermPaymentsWrite},
+	pb.PaymentService_GetPayment_FullMethodName:           {models.PermOrdersRead},
+	pb.PaymentService_GetPaymentsByOrderId_FullMethodName: {models.PermOrdersRead},
+
+	pb.EventService_SubscribeEvents_FullMethodName: {models.PermEventsRead},
+
+	pb.WebhookService_CreateWebhookSubscription_FullMethodName:  {models.PermWebhooksManage},
+	pb.WebhookService_UpdateWebhookSubscription_FullMethodName:  {models.PermWebhooksManage},
+	pb.WebhookService_DeleteWebhookSubscription_FullMethodName:  {models.PermWebhooksManage},
+	pb.WebhookService_GetAllWebhookSubscriptions_FullMethodName: {models.PermWebhooksManage},
+	pb.WebhookService_GetWebhookDeliveries_FullMethodName:       {models.PermWebhooksManage},
+	pb.WebhookService_ReplayWebhookDeliveries_FullMethodName:    {models.PermWebhooksManage},
+
+	pb.ApiKeyService_CreateApiKey_FullMethodName: {models.PermAPIKeysManage},
+	pb.ApiKeyService_RevokeApiKey_FullMethodName: {models.PermAPIKeysManage},
+	pb.ApiKeyService_ListApiKeys_FullMethodName:  {models.PermAPIKeysManage},
+}
+
+// CheckPolicy fails unless every method registered on server is public or has an entry in the policy,
+// so a new RPC cannot ship without deciding who may call it
+func CheckPolicy(server *grpc.Server) error {
+	var missing []string
+	for service, info := range server.GetServiceInfo() {
+		if strings.HasPrefix(service, "grpc.reflection.") {
+			continue
+		}
+		for _, method := range info.Methods {
+			name := "/" + service + "/" + method.Name
+			if _, ok := methodPermissions[name]; !ok && !publicMethods[name] {
+				missing = append(missing, name)
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("no access policy for %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// authorize checks that the caller of method has one of the permissions the policy requires
+func authorize(ctx context.Context, method string) error {
+	if publicMethods[method] || strings.HasPrefix(method, "/grpc.reflection.") {
+		return nil
+	}
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "Not logged in")
+	}
+
+	permissions, ok := methodPermissions[method]
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed", method)
+	}
+	if len(permissions) == 0 {
+		return nil
+	}
+	for _, permission := range permissions {
+		if principal.Can(permission) {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "%s may not call %s", principal, method)
+}
+
+// authorizeOwner lets the caller act on a resource of ownerID when they have the permission, or own the
+// resource. Calls without a caller come from within the OMS and are allowed.
+func authorizeOwner(ctx context.Context, permission models.Permission, ownerID int32) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || principal.Can(permission) {
+		return nil
+	}
+	if principal.UserID != 0 && principal.UserID == ownerID && principal.Can(permission+".own") {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "Access to resources of user %d denied", ownerID)
+}
+
+// authorizeAll lets the caller act only when they have the permission for everyone's resources; owning the
+// resource is not enough. Calls without a caller come from within the OMS and are allowed.
+func authorizeAll(ctx context.Context, permission models.Permission) error {
+	if principal, ok := PrincipalFromContext(ctx); ok && !principal.Can(permission) {
+		return status.Errorf(codes.PermissionDenied, "%s lacks the '%s' permission", principal, permission)
+	}
+	return nil
+}
+
+// authorizeCancel lets customers cancel their own orders only until they are confirmed; callers who may
+// write every order cancel whatever the lifecycle allows
+func authorizeCancel(ctx context.Context, order *models.Order) error {
+	if authorizeAll(ctx, models.PermOrdersWrite) == nil || order.Status.IsEditable() {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "Order in status '%s' can no longer be cancelled by its customer", order.Status)
+}
+
+// authorizeOrder is authorizeOwner for the user who placed orderID
+func authorizeOrder(ctx context.Context, db *gorm.DB, permission models.Permission, orderID int32) error {
+	if principal, ok := PrincipalFromContext(ctx); !ok || principal.Can(permission) {
+		return nil
+	}
+	var order models.Order
+	if err := db.WithContext(ctx).Select("user_id").First(&order, orderID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Errorf(codes.NotFound, "Order not found")
+		}
+		log.Println("Error fetching order:", err)
+		return status.Errorf(codes.Internal, "Failed to fetch order")
+	}
+	return authorizeOwner(ctx, permission, order.UserID)
+}
+
+// AuthorizeUnary checks the policy for unary RPCs; it runs after the Authenticator
+func AuthorizeUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// AuthorizeStream checks the policy for streaming RPCs; it runs after the Authenticator
+func AuthorizeStream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := authorize(stream.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newPolicyServer registers every service of the OMS, as the server does
+func newPolicyServer() *grpc.Server {
+	server := grpc.NewServer()
+	pb.RegisterOmsItemServiceServer(server, &OmsItemServiceServer{})
+	pb.RegisterUserServiceServer(server, &OmsUserServiceServer{})
+	pb.RegisterAuthServiceServer(server, &AuthServiceServer{})
+	pb.RegisterApiKeyServiceServer(server, &APIKeyServiceServer{})
+	pb.RegisterPaymentServiceServer(server, &PaymentServiceServer{})
+	pb.RegisterOrderServiceServer(server, &OrderServiceServer{})
+	pb.RegisterDiscountServiceServer(server, &DiscountServiceServer{})
+	pb.RegisterCurrencyServiceServer(server, &CurrencyServiceServer{})
+	pb.RegisterTaxServiceServer(server, &TaxServiceServer{})
+	pb.RegisterFulfillmentServiceServer(server, &FulfillmentServiceServer{})
+	pb.RegisterReturnServiceServer(server, &ReturnServiceServer{})
+	pb.RegisterEventServiceServer(server, &EventServiceServer{})
+	pb.RegisterWebhookServiceServer(server, &WebhookServiceServer{})
+	return server
+}
+
+func TestCheckPolicy(t *testing.T) {
+	server := newPolicyServer()
+	if err := CheckPolicy(server); err != nil {
+		t.Fatalf("CheckPolicy: %v", err)
+	}
+
+	// A method without an entry keeps the server from starting
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "test.Unlisted",
+		HandlerType: (*interface{})(nil),
+		Methods:     []grpc.MethodDesc{{MethodName: "Do"}},
+	}, struct{}{})
+	err := CheckPolicy(server)
+	if err == nil || !strings.Contains(err.Error(), "/test.Unlisted/Do") {
+		t.Errorf("CheckPolicy = %v, want it to name /test.Unlisted/Do", err)
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	customer := ContextWithPrincipal(context.Background(), &Principal{UserID: 7, Role: models.RoleCustomer})
+	support := ContextWithPrincipal(context.Background(), &Principal{UserID: 8, Role: models.RoleSupport})
+	warehouse := ContextWithPrincipal(context.Background(), &Principal{APIKeyID: 3, Scopes: []models.Permission{models.PermStockWrite}})
+
+	for _, test := range []struct {
+		name   string
+		ctx    context.Context
+		method string
+		want   codes.Code
+	}{
+		{"public", context.Background(), pb.AuthService_Login_FullMethodName, codes.OK},
+		{"anonymous", context.Background(), pb.OrderService_GetOrderById_FullMethodName, codes.Unauthenticated},
+		{"customer own order", customer, pb.OrderService_GetOrderById_FullMethodName, codes.OK},
+		{"customer cancels", customer, pb.OrderService_DeleteOrderById_FullMethodName, codes.OK},
+		{"customer edits", customer, pb.OrderService_UpdateOrderById_FullMethodName, codes.OK},
+		{"customer transitions", customer, pb.OrderService_TransitionOrder_FullMethodName, codes.OK},
+		{"customer requests return", customer, pb.ReturnService_RequestReturn_FullMethodName, codes.OK},
+		{"customer approves return", customer, pb.ReturnService_ApproveReturn_FullMethodName, codes.PermissionDenied},
+		{"customer lists orders", customer, pb.OrderService_GetAllOrders_FullMethodName, codes.PermissionDenied},
+		{"support approves return", support, pb.ReturnService_ApproveReturn_FullMethodName, codes.OK},
+		{"support captures", support, pb.PaymentService_CapturePayment_FullMethodName, codes.PermissionDenied},
+		{"key in scope", warehouse, pb.OmsItemService_AdjustStock_FullMethodName, codes.OK},
+		{"key out of scope", warehouse, pb.OrderService_GetOrderById_FullMethodName, codes.PermissionDenied},
+		{"unlisted", support, "/test.Unlisted/Do", codes.PermissionDenied},
+	} {
+		if got := status.Code(authorize(test.ctx, test.method)); got != test.want {
+			t.Errorf("%s: authorize(%s) = %v, want %v", test.name, test.method, got, test.want)
+		}
+	}
+}
+
+func TestAuthorizeOwner(t *testing.T) {
+	customer := ContextWithPrincipal(context.Background(), &Principal{UserID: 7, Role: models.RoleCustomer})
+	support := ContextWithPrincipal(context.Background(), &Principal{UserID: 8, Role: models.RoleSupport})
+
+	if err := authorizeOwner(customer, models.PermOrdersWrite, 7); err != nil {
+		t.Errorf("customer on their own order: %v", err)
+	}
+	if got := status.Code(authorizeOwner(customer, models.PermOrdersWrite, 9)); got != codes.PermissionDenied {
+		t.Errorf("customer on another user's order = %v, want PermissionDenied", got)
+	}
+	if err := authorizeOwner(support, models.PermOrdersWrite, 9); err != nil {
+		t.Errorf("support on another user's order: %v", err)
+	}
+	if err := authorizeOwner(context.Background(), models.PermOrdersWrite, 9); err != nil {
+		t.Errorf("internal call: %v", err)
+	}
+
+	if got := status.Code(authorizeAll(customer, models.PermOrdersWrite)); got != codes.PermissionDenied {
+		t.Errorf("authorizeAll for a customer = %v, want PermissionDenied", got)
+	}
+	if err := authorizeAll(support, models.PermOrdersWrite); err != nil {
+		t.Errorf("authorizeAll for support: %v", err)
+	}
+}
+
+func TestAuthorizeCancel(t *testing.T) {
+	customer := ContextWithPrincipal(context.Background(), &Principal{UserID: 7, Role: models.RoleCustomer})
+	support := ContextWithPrincipal(context.Background(), &Principal{UserID: 8, Role: models.RoleSupport})
+
+	for _, test := range []struct {
+		ctx    context.Context
+		status models.OrderStatus
+		want   codes.Code
+	}{
+		{customer, models.OrderStatusDraft, codes.OK},
+		{customer, models.OrderStatusPending, codes.OK},
+		{customer, models.OrderStatusConfirmed, codes.PermissionDenied},
+		{customer, models.OrderStatusPacked, codes.PermissionDenied},
+		{support, models.OrderStatusConfirmed, codes.OK},
+		{context.Background(), models.OrderStatusPacked, codes.OK},
+	} {
+		order := &models.Order{UserID: 7, Status: test.status}
+		if got := status.Code(authorizeCancel(test.ctx, order)); got != test.want {
+			t.Errorf("cancelling a %s order = %v, want %v", test.status, got, test.want)
+		}
+	}
+}
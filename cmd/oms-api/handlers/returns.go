@@ -0,0 +1,409 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReturnServiceServer implements the gRPC ReturnService
+type ReturnServiceServer struct {
+	pb.UnimplementedReturnServiceServer
+	DB       *gorm.DB
+	Payments *PaymentServiceServer // Refunds returns through the payment provider
+}
+
+// paidPerLine returns what was paid for each order line: its price less its share of the discounts, plus
+// its tax unless the prices included it
+func paidPerLine(order *models.Order, items []models.OrderItem) []int64 {
+	discounts := make([]int64, len(items))
+	var recorded int64
+	for i := range items {
+		discounts[i] = items[i].Discount.MinorUnits
+		recorded += discounts[i]
+	}
+
+	// Orders placed before discounts were recorded per line spread their adjustments now
+	if recorded == 0 && len(order.Adjustments) > 0 {
+		legacy := models.Discounts{Currency: order.TotalPrice.Currency}
+		for _, adjustment := range order.Adjustments {
+			discount := models.AppliedDiscount{Amount: adjustment.Amount.MinorUnits}
+			if adjustment.ItemID != nil {
+				discount.ItemID = *adjustment.ItemID
+			}
+			legacy.Applied = append(legacy.Applied, discount)
+		}
+		discounts = allocateDiscounts(items, legacy)
+	}
+
+	paid := make([]int64, len(items))
+	for i := range items {
+		paid[i] = items[i].Subtotal() - discounts[i]
+		if !order.PricesIncludeTax {
+			paid[i] += items[i].Tax.MinorUnits
+		}
+	}
+	return paid
+}
+
+// returnedQuantities returns, per item, the units of an order in returns that were not rejected
+func returnedQuantities(tx *gorm.DB, orderID int32, statuses ...models.ReturnStatus) (map[int32]int32, error) {
+	query := tx.Joins("JOIN returns ON returns.id = return_lines.return_id").Where("returns.order_id = ?", orderID)
+	if len(statuses) > 0 {
+		query = query.Where("returns.status IN ?", statuses)
+	} else {
+		query = query.Where("returns.status <> ?", models.ReturnStatusRejected)
+	}
+
+	var lines []models.ReturnLine
+	if err := query.Find(&lines).Error; err != nil {
+		log.Println("Error fetching return lines:", err)
+		return nil, status.Errorf(codes.Internal, "Failed to fetch returns")
+	}
+	returned := make(map[int32]int32)
+	for _, line := range lines {
+		returned[line.ItemID] += line.Quantity
+	}
+	return returned, nil
+}
+
+// loadReturn fetches a return with its lines inside tx
+func loadReturn(tx *gorm.DB, returnID int32) (models.Return, error) {
+	var ret models.Return
+	if err := tx.Preload("Lines", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id")
+	}).First(&ret, returnID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ret, status.Errorf(codes.NotFound, "Return not found")
+		}
+		return ret, status.Errorf(codes.Internal, "Failed to fetch return: %v", err)
+	}
+	return ret, nil
+}
+
+// moveReturn moves a return to target, writing the given extra columns with the status
+func moveReturn(tx *gorm.DB, ret *models.Return, target models.ReturnStatus, columns map[string]interface{}) error {
+	if !ret.Status.CanTransitionTo(target) {
+		return status.Errorf(codes.FailedPrecondition, "Return cannot move from '%s' to '%s'", ret.Status, target)
+	}
+
+	columns["status"] = target
+	if err := tx.Model(&models.Return{}).Where("id = ?", ret.ID).Updates(columns).Error; err != nil {
+		log.Println("Error updating return:", err)
+		return status.Errorf(codes.Internal, "Failed to update return")
+	}
+	ret.Status = target
+	return nil
+}
+
+// changeReturn locks the order of a return, reloads the return and applies change to it, all in one
+// transaction, and returns the updated return
+func (s *ReturnServiceServer) changeReturn(ctx context.Context, returnID int32, change func(tx *gorm.DB, order *models.Order, ret *models.Return) error) (*pb.Return, error) {
+	var ret models.Return
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		found, err := loadReturn(tx, returnID)
+		if err != nil {
+			return err
+		}
+
+		// Lock the order first so returns of the same order change one at a time
+		order, err := lockOrder(tx, found.OrderID)
+		if err != nil {
+			return err
+		}
+		if ret, err = loadReturn(tx, returnID); err != nil {
+			return err
+		}
+		return change(tx, &order, &ret)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := loadReturn(s.DB.WithContext(ctx), ret.ID)
+	if err != nil {
+		return nil, err
+	}
+	return updated.ToPb(), nil
+}
+
+// RequestReturn opens a return for units of a delivered order and computes what each line refunds
+func (s *ReturnServiceServer) RequestReturn(ctx context.Context, req *pb.RequestReturnRequest) (*pb.Return, error) {
+	// Customers only return items of their own orders
+	if err := authorizeOrder(ctx, s.DB, models.PermReturnsWrite, req.GetOrderId()); err != nil {
+		return nil, err
+	}
+
+	// Validate the requested lines
+	if len(req.GetLines()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "A return with at least one line is required")
+	}
+	seen := map[int32]bool{}
+	for _, line := range req.GetLines() {
+		if line.GetQuantity() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Quantity for item %d must be positive", line.GetItemId())
+		}
+		if strings.TrimSpace(line.GetReason()) == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "A reason is required for item %d", line.GetItemId())
+		}
+		if seen[line.GetItemId()] {
+			return nil, status.Errorf(codes.InvalidArgument, "Item %d is listed more than once", line.GetItemId())
+		}
+		seen[line.GetItemId()] = true
+	}
+
+	var ret models.Return
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the order; only delivered orders can be returned
+		order, err := lockOrder(tx, req.GetOrderId())
+		if err != nil {
+			return err
+		}
+		if order.Status != models.OrderStatusDelivered {
+			return status.Errorf(codes.FailedPrecondition, "Only delivered orders can be returned; order is '%s'", order.Status)
+		}
+
+		// Load the lines and discounts recorded when the order was placed
+		var items []models.OrderItem
+		if err := tx.Where("order_id = ?", order.ID).Order("id").Find(&items).Error; err != nil {
+			log.Println("Error fetching order items:", err)
+			return status.Errorf(codes.Internal, "Failed to fetch order items")
+		}
+		if err := tx.Where("order_id = ?", order.ID).Find(&order.Adjustments).Error; err != nil {
+			log.Println("Error fetching order adjustments:", err)
+			return status.Errorf(codes.Internal, "Failed to fetch order adjustments")
+		}
+
+		// Sum what was ordered and paid per item
+		ordered := quantitiesByItem(items)
+		paid := make(map[int32]int64)
+		for i, amount := range paidPerLine(&order, items) {
+			paid[items[i].ItemID] += amount
+		}
+
+		// Units already in other returns cannot be returned again
+		returned, err := returnedQuantities(tx, order.ID)
+		if err != nil {
+			return err
+		}
+
+		// Refund each unit its share of what was paid for the item. Shares are taken cumulatively, so the
+		// returns of all units of an item refund exactly what was paid for it.
+		currency := order.FinalPrice.Currency
+		ret = models.Return{OrderID: order.ID, Status: models.ReturnStatusRequested, RefundAmount: models.NewMoney(0, currency)}
+		for _, line := range req.GetLines() {
+			itemID, quantity := line.GetItemId(), line.GetQuantity()
+			before := returned[itemID]
+			if quantity > ordered[itemID]-before {
+				return status.Errorf(codes.FailedPrecondition, "Only %d unit(s) of item %d can still be returned", ordered[itemID]-before, itemID)
+			}
+			total := int64(ordered[itemID])
+			refund := paid[itemID]*int64(before+quantity)/total - paid[itemID]*int64(before)/total
+
+			ret.Lines = append(ret.Lines, models.ReturnLine{
+				ItemID:       itemID,
+				Quantity:     quantity,
+				Reason:       strings.TrimSpace(line.GetReason()),
+				RefundAmount: models.NewMoney(refund, currency),
+			})
+			ret.RefundAmount.MinorUnits += refund
+		}
+
+		// Insert the return with its lines
+		if err := tx.Create(&ret).Error; err != nil {
+			log.Println("Error inserting return:", err)
+			return status.Errorf(codes.Internal, "Failed to insert return")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret.ToPb(), nil
+}
+
+func (s *ReturnServiceServer) ApproveReturn(ctx context.Context, req *pb.ApproveReturnRequest) (*pb.Return, error) {
+	return s.changeReturn(ctx, req.GetReturnId(), func(tx *gorm.DB, order *models.Order, ret *models.Return) error {
+		return moveReturn(tx, ret, models.ReturnStatusApproved, map[string]interface{}{"note": req.GetNote()})
+	})
+}
+
+func (s *ReturnServiceServer) RejectReturn(ctx context.Context, req *pb.RejectReturnRequest) (*pb.Return, error) {
+	if strings.TrimSpace(req.GetNote()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "A note explaining the rejection is required")
+	}
+	return s.changeReturn(ctx, req.GetReturnId(), func(tx *gorm.DB, order *models.Order, ret *models.Return) error {
+		return moveReturn(tx, ret, models.ReturnStatusRejected, map[string]interface{}{"note": req.GetNote()})
+	})
+}
+
+// ReceiveReturn records that the returned units arrived, optionally putting them back into stock
+func (s *ReturnServiceServer) ReceiveReturn(ctx context.Context, req *pb.ReceiveReturnRequest) (*pb.Return, error) {
+	return s.changeReturn(ctx, req.GetReturnId(), func(tx *gorm.DB, order *models.Order, ret *models.Return) error {
+		if err := moveReturn(tx, ret, models.ReturnStatusReceived, map[string]interface{}{"restocked": req.GetRestock()}); err != nil {
+			return err
+		}
+		if !req.GetRestock() {
+			return nil
+		}
+
+		// Put the units back on hand
+		lines := make([]models.OrderItem, 0, len(ret.Lines))
+		for _, line := range ret.Lines {
+			lines = append(lines, models.OrderItem{ItemID: line.ItemID, Quantity: line.Quantity})
+		}
+		return applyStockChange(tx, &order.ID, lines, restockChange)
+	})
+}
+
+// beginRefund records the refund of a received return as a pending attempt on the captured payment of its
+// order, committed before the provider is asked. It returns no attempt when there is nothing to ask: the
+// return refunds nothing, or an earlier call made the refund but did not get to mark the return refunded.
+func (s *ReturnServiceServer) beginRefund(ctx context.Context, returnID int32) (*models.Payment, *models.PaymentAttempt, error) {
+	var payment models.Payment
+	var attempt *models.PaymentAttempt
+	_, err := s.changeReturn(ctx, returnID, func(tx *gorm.DB, order *models.Order, ret *models.Return) error {
+		if !ret.Status.CanTransitionTo(models.ReturnStatusRefunded) {
+			return status.Errorf(codes.FailedPrecondition, "Return cannot move from '%s' to '%s'", ret.Status, models.ReturnStatusRefunded)
+		}
+		if ret.RefundAmount.MinorUnits == 0 {
+			return nil
+		}
+		if s.Payments == nil {
+			return status.Errorf(codes.FailedPrecondition, "No payment provider is configured to refund return %d", ret.ID)
+		}
+
+		// A refund made by an earlier call is not made again, and one still in flight is waited for
+		if ret.RefundAttemptID != nil {
+			var earlier models.PaymentAttempt
+			if err := tx.First(&earlier, *ret.RefundAttemptID).Error; err != nil {
+				log.Println("Error fetching payment attempt:", err)
+				return status.Errorf(codes.Internal, "Failed to fetch payment attempt")
+			}
+			switch {
+			case earlier.Result == models.PaymentAttemptSucceeded:
+				return nil
+			case earlier.Result == models.PaymentAttemptPending && earlier.CreatedAt.After(time.Now().Add(-2*s.Payments.timeout())):
+				return status.Errorf(codes.Aborted, "The refund of return %d is in flight; try again shortly", ret.ID)
+			}
+		}
+
+		// Refund from the first captured payment with enough left on it
+		var payments []models.Payment
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("order_id = ? AND status IN ?", order.ID,
+			[]models.PaymentStatus{models.PaymentStatusCaptured, models.PaymentStatusPartiallyRefunded}).Order("id").Find(&payments).Error; err != nil {
+			log.Println("Error fetching payments:", err)
+			return status.Errorf(codes.Internal, "Failed to fetch payments")
+		}
+		for i := range payments {
+			refundable := payments[i].Refundable()
+			if refundable.Currency != ret.RefundAmount.Currency || refundable.MinorUnits < ret.RefundAmount.MinorUnits {
+				continue
+			}
+
+			begun, err := s.Payments.beginOperation(tx, &payments[i], models.PaymentOperationRefund, ret.RefundAmount)
+			if err != nil {
+				return err
+			}
+			if err := tx.Model(&models.Return{}).Where("id = ?", ret.ID).
+				Updates(map[string]interface{}{"payment_id": payments[i].ID, "refund_attempt_id": begun.ID}).Error; err != nil {
+				log.Println("Error updating return:", err)
+				return status.Errorf(codes.Internal, "Failed to update return")
+			}
+			payment, attempt = payments[i], &begun
+			return nil
+		}
+		return status.Errorf(codes.FailedPrecondition, "Order %d has no captured payment with %s left to refund", order.ID, ret.RefundAmount)
+	})
+	return &payment, attempt, err
+}
+
+// RefundReturn refunds a received return through the payment provider and records it. The refund is
+// committed as a pending payment attempt before the provider is asked and the answer is kept on its own,
+// so a refund the provider made is neither lost nor made twice. The order moves to returned once all its
+// units have been refunded.
+func (s *ReturnServiceServer) RefundReturn(ctx context.Context, req *pb.RefundReturnRequest) (*pb.Return, error) {
+	// Record the refund and ask the provider to make it
+	payment, attempt, err := s.beginRefund(ctx, req.GetReturnId())
+	if err != nil {
+		return nil, err
+	}
+	if attempt != nil {
+		if err := s.Payments.runOperation(ctx, payment, attempt, ""); err != nil {
+			return nil, err
+		}
+		if attempt.Result != models.PaymentAttemptSucceeded {
+			return nil, status.Errorf(codes.FailedPrecondition, "Payment %d was not refunded: %s", payment.ID, attempt.Error)
+		}
+	}
+
+	// Mark the return refunded once the provider made the refund
+	return s.changeReturn(ctx, req.GetReturnId(), func(tx *gorm.DB, order *models.Order, ret *models.Return) error {
+		if ret.RefundAmount.MinorUnits > 0 {
+			var refund models.PaymentAttempt
+			if ret.RefundAttemptID != nil {
+				if err := tx.First(&refund, *ret.RefundAttemptID).Error; err != nil {
+					log.Println("Error fetching payment attempt:", err)
+					return status.Errorf(codes.Internal, "Failed to fetch payment attempt")
+				}
+			}
+			if refund.Result != models.PaymentAttemptSucceeded {
+				return status.Errorf(codes.FailedPrecondition, "Return %d was not refunded through its payment yet", ret.ID)
+			}
+		}
+		if err := moveReturn(tx, ret, models.ReturnStatusRefunded, map[string]interface{}{"refunded_at": time.Now()}); err != nil {
+			return err
+		}
+
+		// Compare the refunded units with the ordered ones
+		var items []models.OrderItem
+		if err := tx.Where("order_id = ?", order.ID).Find(&items).Error; err != nil {
+			log.Println("Error fetching order items:", err)
+			return status.Errorf(codes.Internal, "Failed to fetch order items")
+		}
+		refunded, err := returnedQuantities(tx, order.ID, models.ReturnStatusRefunded)
+		if err != nil {
+			return err
+		}
+		for itemID, quantity := range quantitiesByItem(items) {
+			if refunded[itemID] < quantity {
+				return nil
+			}
+		}
+		return transitionOrder(tx, order, models.OrderStatusReturned, fmt.Sprintf("Every item refunded by return %d", ret.ID))
+	})
+}
+
+func (s *ReturnServiceServer) GetReturn(ctx context.Context, req *pb.GetReturnRequest) (*pb.Return, error) {
+	ret, err := loadReturn(s.DB.WithContext(ctx), req.GetReturnId())
+	if err != nil {
+		return nil, err
+	}
+	return ret.ToPb(), nil
+}
+
+func (s *ReturnServiceServer) GetReturnsByOrderId(ctx context.Context, req *pb.GetReturnsByOrderIdRequest) (*pb.GetReturnsResponse, error) {
+	var returns []models.Return
+	if err := s.DB.WithContext(ctx).Preload("Lines", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id")
+	}).Where("order_id = ?", req.GetOrderId()).Order("id").Find(&returns).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to fetch returns: %v", err)
+	}
+
+	response := &pb.GetReturnsResponse{}
+	for i := range returns {
+		response.Returns = append(response.Returns, returns[i].ToPb())
+	}
+	return response, nil
+}
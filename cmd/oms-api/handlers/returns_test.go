@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+)
+
+func TestPaidPerLine(t *testing.T) {
+	mug := int32(2)
+	recorded := []models.OrderItem{
+		{ItemID: 1, Quantity: 1, Price: models.NewMoney(10000, "USD"), Discount: models.NewMoney(1000, "USD"), Tax: models.NewMoney(1800, "USD")},
+		{ItemID: 2, Quantity: 2, Price: models.NewMoney(2500, "USD"), Discount: models.NewMoney(500, "USD"), Tax: models.NewMoney(900, "USD")},
+	}
+	legacy := []models.OrderItem{
+		{ItemID: 1, Quantity: 1, Price: models.NewMoney(10000, "USD")},
+		{ItemID: 2, Quantity: 2, Price: models.NewMoney(2500, "USD")},
+	}
+	adjustments := []models.OrderAdjustment{
+		{Scope: models.DiscountScopeOrder, Amount: models.NewMoney(1500, "USD")},
+		{Scope: models.DiscountScopeLine, ItemID: &mug, Amount: models.NewMoney(300, "USD")},
+	}
+
+	for _, test := range []struct {
+		name  string
+		order models.Order
+		items []models.OrderItem
+		want  []int64
+	}{
+		{"tax added on top is refunded", models.Order{}, recorded, []int64{10800, 5400}},
+		{"tax included in the price", models.Order{PricesIncludeTax: true}, recorded, []int64{9000, 4500}},
+		{"nothing discounted", models.Order{PricesIncludeTax: true}, legacy, []int64{10000, 5000}},
+		// The line adjustment goes to the mugs, then the order adjustment is split over what is left,
+		// 10000 to 4700, with the odd cent to the larger remainder
+		{"adjustments of orders placed before line discounts", models.Order{PricesIncludeTax: true, Adjustments: adjustments,
+			TotalPrice: models.NewMoney(15000, "USD")}, legacy, []int64{8980, 4220}},
+	} {
+		if got := paidPerLine(&test.order, test.items); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,331 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TaxLine is one order line to be taxed
+type TaxLine struct {
+	ItemID      int32
+	TaxCategory string
+	Amount      int64 // Minor units of the line after its discount
+}
+
+// TaxRequest asks for the tax on the lines of an order
+type TaxRequest struct {
+	Region           string // Shipping region; orders without one are not taxed
+	Currency         string
+	PricesIncludeTax bool // Whether Amount includes the tax, which is then extracted rather than added
+	Lines            []TaxLine
+}
+
+// LineTax is the tax on one line, in the order of TaxRequest.Lines
+type LineTax struct {
+	Rate   float64 // Percentage
+	Amount int64   // Minor units
+}
+
+// TaxCalculator computes the tax on an order. db is the handle the order is priced with, so calculators
+// that read tables see the same transaction; others may ignore it.
+type TaxCalculator interface {
+	CalculateTax(db *gorm.DB, request TaxRequest) ([]LineTax, error)
+}
+
+// TableTaxCalculator looks rates up in the tax jurisdiction and rate tables. The jurisdiction of the
+// region wins over the one of its country; a line takes the rate of its tax category, or the standard rate.
+// Regions without a jurisdiction are not taxed.
+type TableTaxCalculator struct{}
+
+func (TableTaxCalculator) CalculateTax(db *gorm.DB, request TaxRequest) ([]LineTax, error) {
+	taxes := make([]LineTax, len(request.Lines))
+	if request.Region == "" {
+		return taxes, nil
+	}
+
+	// Find the most specific jurisdiction for the region
+	var jurisdictions []models.TaxJurisdiction
+	candidates := models.TaxRegionCandidates(request.Region)
+	if err := db.Preload("Rates").Where("region IN ?", candidates).Find(&jurisdictions).Error; err != nil {
+		log.Println("Error fetching tax jurisdiction:", err)
+		return nil, status.Errorf(codes.Internal, "Failed to fetch tax rates")
+	}
+	var jurisdiction *models.TaxJurisdiction
+	for _, region := range candidates {
+		for i := range jurisdictions {
+			if jurisdiction == nil && jurisdictions[i].Region == region {
+				jurisdiction = &jurisdictions[i]
+			}
+		}
+	}
+	if jurisdiction == nil {
+		return taxes, nil
+	}
+
+	rates := map[string]float64{}
+	for _, rate := range jurisdiction.Rates {
+		rates[rate.TaxCategory] = rate.Rate
+	}
+
+	// Tax every line at the rate of its category
+	for i, line := range request.Lines {
+		rate, ok := rates[line.TaxCategory]
+		if !ok {
+			rate = rates[""]
+		}
+		taxes[i] = LineTax{Rate: rate, Amount: models.TaxOn(line.Amount, rate, request.PricesIncludeTax)}
+	}
+	return taxes, nil
+}
+
+// applyTax spreads the discounts over the lines of order, taxes each line with calculator and sets the
+// tax and final price of order. order.TaxRegion and order.PricesIncludeTax must be set.
+func applyTax(db *gorm.DB, order *models.Order, discounts models.Discounts, calculator TaxCalculator) error {
+	lineDiscounts := allocateDiscounts(order.Items, discounts)
+
+	request := TaxRequest{Region: order.TaxRegion, Currency: discounts.Currency, PricesIncludeTax: order.PricesIncludeTax}
+	for i, item := range order.Items {
+		request.Lines = append(request.Lines, TaxLine{
+			ItemID:      item.ItemID,
+			TaxCategory: item.TaxCategory,
+			Amount:      item.Subtotal() - lineDiscounts[i],
+		})
+	}
+	taxes, err := calculator.CalculateTax(db, request)
+	if err != nil {
+		return err
+	}
+	if len(taxes) != len(order.Items) {
+		log.Printf("Tax calculator returned %d taxes for %d lines", len(taxes), len(order.Items))
+		return status.Errorf(codes.Internal, "Failed to calculate tax")
+	}
+
+	// Record the discount and tax of every line
+	var taxTotal int64
+	for i := range order.Items {
+		order.Items[i].Discount = models.NewMoney(lineDiscounts[i], discounts.Currency)
+		order.Items[i].TaxRate = taxes[i].Rate
+		order.Items[i].Tax = models.NewMoney(taxes[i].Amount, discounts.Currency)
+		taxTotal += taxes[i].Amount
+	}
+	order.TaxTotal = models.NewMoney(taxTotal, discounts.Currency)
+	order.FinalPrice = calculateTotalPrice(order.Items, discounts, order.TaxTotal, order.PricesIncludeTax)
+	return nil
+}
+
+// allocateDiscounts returns the share of the discounts taken off each line, in minor units. Line discounts
+// go to the lines of their item, order discounts to the lines they were computed on in proportion to what
+// is left of each line; the remainder of the split goes to the largest fractions so the shares add up exactly.
+func allocateDiscounts(items []models.OrderItem, discounts models.Discounts) []int64 {
+	allocated := make([]int64, len(items))
+	allocate := func(discount models.AppliedDiscount) {
+		// Weigh the lines the discount applies to by what is left of them
+		weights := make([]int64, len(items))
+		var totalWeight int64
+		for i, item := range items {
+			if !discountCoversLine(discount, item.ItemID) {
+				continue
+			}
+			weights[i] = item.Subtotal() - allocated[i]
+			totalWeight += weights[i]
+		}
+		amount := discount.Amount
+		if amount > totalWeight {
+			amount = totalWeight
+		}
+		if amount <= 0 {
+			return
+		}
+
+		// Split in proportion, then hand out the remainder one minor unit at a time
+		remainders := make([]int64, len(items))
+		var given int64
+		for i := range items {
+			share := amount * weights[i] / totalWeight
+			remainders[i] = amount * weights[i] % totalWeight
+			allocated[i] += share
+			given += share
+		}
+		for ; given < amount; given++ {
+			largest := -1
+			for i := range items {
+				if weights[i] > 0 && (largest < 0 || remainders[i] > remainders[largest]) {
+					largest = i
+				}
+			}
+			allocated[largest]++
+			remainders[largest] = -1
+		}
+	}
+
+	// Line discounts first, as they are bound to their lines
+	for _, discount := range discounts.Applied {
+		if discount.ItemID != 0 {
+			allocate(discount)
+		}
+	}
+	for _, discount := range discounts.Applied {
+		if discount.ItemID == 0 {
+			allocate(discount)
+		}
+	}
+	return allocated
+}
+
+// discountCoversLine reports whether a discount is spread over the line of itemID
+func discountCoversLine(discount models.AppliedDiscount, itemID int32) bool {
+	if discount.ItemID != 0 {
+		return discount.ItemID == itemID
+	}
+	if len(discount.ItemIDs) == 0 {
+		return true
+	}
+	for _, id := range discount.ItemIDs {
+		if id == itemID {
+			return true
+		}
+	}
+	return false
+}
+
+// TaxServiceServer implements the gRPC TaxService
+type TaxServiceServer struct {
+	pb.UnimplementedTaxServiceServer
+	DB *gorm.DB
+}
+
+// findJurisdiction loads the jurisdiction of region with its rates
+func (s *TaxServiceServer) findJurisdiction(ctx context.Context, region string) (models.TaxJurisdiction, error) {
+	var jurisdiction models.TaxJurisdiction
+	err := s.DB.WithContext(ctx).Preload("Rates", func(db *gorm.DB) *gorm.DB {
+		return db.Order("tax_category")
+	}).Where("region = ?", models.NormalizeTaxRegion(region)).First(&jurisdiction).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return jurisdiction, status.Errorf(codes.NotFound, "Tax jurisdiction not found")
+	}
+	if err != nil {
+		return jurisdiction, status.Errorf(codes.Internal, "Failed to fetch tax jurisdiction: %v", err)
+	}
+	return jurisdiction, nil
+}
+
+// SetTaxJurisdiction creates the jurisdiction of a region or renames the existing one
+func (s *TaxServiceServer) SetTaxJurisdiction(ctx context.Context, req *pb.SetTaxJurisdictionRequest) (*pb.TaxJurisdiction, error) {
+	region := models.NormalizeTaxRegion(req.GetJurisdiction().GetRegion())
+	if region == "" || req.GetJurisdiction().GetName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Region and name are required")
+	}
+
+	// Create the jurisdiction or rename the existing one
+	jurisdiction := models.TaxJurisdiction{Region: region, Name: req.GetJurisdiction().GetName()}
+	if err := s.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "region"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "updated_at"}),
+	}).Create(&jurisdiction).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to save tax jurisdiction: %v", err)
+	}
+
+	jurisdiction, err := s.findJurisdiction(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+	return jurisdiction.ToPb(), nil
+}
+
+func (s *TaxServiceServer) GetAllTaxJurisdictions(ctx context.Context, req *pb.GetAllTaxJurisdictionsRequest) (*pb.GetAllTaxJurisdictionsResponse, error) {
+	var jurisdictions []models.TaxJurisdiction
+	if err := s.DB.WithContext(ctx).Preload("Rates", func(db *gorm.DB) *gorm.DB {
+		return db.Order("tax_category")
+	}).Order("region").Find(&jurisdictions).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to fetch tax jurisdictions: %v", err)
+	}
+
+	response := &pb.GetAllTaxJurisdictionsResponse{}
+	for i := range jurisdictions {
+		response.Jurisdictions = append(response.Jurisdictions, jurisdictions[i].ToPb())
+	}
+	return response, nil
+}
+
+// DeleteTaxJurisdiction removes a jurisdiction with all its rates; orders already placed keep their tax
+func (s *TaxServiceServer) DeleteTaxJurisdiction(ctx context.Context, req *pb.DeleteTaxJurisdictionRequest) (*pb.DeleteTaxJurisdictionResponse, error) {
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var jurisdiction models.TaxJurisdiction
+		if err := tx.Where("region = ?", models.NormalizeTaxRegion(req.GetRegion())).First(&jurisdiction).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Errorf(codes.NotFound, "Tax jurisdiction not found")
+			}
+			return status.Errorf(codes.Internal, "Failed to fetch tax jurisdiction: %v", err)
+		}
+		if err := tx.Where("jurisdiction_id = ?", jurisdiction.ID).Delete(&models.TaxRate{}).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to delete tax rates: %v", err)
+		}
+		if err := tx.Delete(&jurisdiction).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to delete tax jurisdiction: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteTaxJurisdictionResponse{Message: "Tax jurisdiction deleted successfully"}, nil
+}
+
+// SetTaxRate creates or replaces the rate a jurisdiction charges on a tax category
+func (s *TaxServiceServer) SetTaxRate(ctx context.Context, req *pb.SetTaxRateRequest) (*pb.TaxJurisdiction, error) {
+	rate, err := models.TaxRateFromPb(req.GetRate())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid tax rate: %v", err)
+	}
+
+	// The jurisdiction must exist
+	jurisdiction, err := s.findJurisdiction(ctx, req.GetRegion())
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the rate or replace the existing one
+	rate.JurisdictionID = jurisdiction.ID
+	if err := s.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "jurisdiction_id"}, {Name: "tax_category"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+	}).Create(&rate).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to save tax rate: %v", err)
+	}
+
+	jurisdiction, err = s.findJurisdiction(ctx, jurisdiction.Region)
+	if err != nil {
+		return nil, err
+	}
+	return jurisdiction.ToPb(), nil
+}
+
+func (s *TaxServiceServer) DeleteTaxRate(ctx context.Context, req *pb.DeleteTaxRateRequest) (*pb.TaxJurisdiction, error) {
+	jurisdiction, err := s.findJurisdiction(ctx, req.GetRegion())
+	if err != nil {
+		return nil, err
+	}
+
+	result := s.DB.WithContext(ctx).Where("jurisdiction_id = ? AND tax_category = ?", jurisdiction.ID, models.NormalizeTaxCategory(req.GetTaxCategory())).Delete(&models.TaxRate{})
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete tax rate: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "Tax rate not found")
+	}
+
+	jurisdiction, err = s.findJurisdiction(ctx, jurisdiction.Region)
+	if err != nil {
+		return nil, err
+	}
+	return jurisdiction.ToPb(), nil
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// categoryTaxCalculator taxes every line at the rate of its tax category, or the standard rate, without a
+// database
+type categoryTaxCalculator map[string]float64
+
+func (c categoryTaxCalculator) CalculateTax(_ *gorm.DB, request TaxRequest) ([]LineTax, error) {
+	var taxes []LineTax
+	for _, line := range request.Lines {
+		rate, ok := c[line.TaxCategory]
+		if !ok {
+			rate = c[""]
+		}
+		taxes = append(taxes, LineTax{Rate: rate, Amount: models.TaxOn(line.Amount, rate, request.PricesIncludeTax)})
+	}
+	return taxes, nil
+}
+
+func TestApplyTax(t *testing.T) {
+	standard := categoryTaxCalculator{"": 20}
+	reduced := categoryTaxCalculator{"": 20, "food": 0}
+	orderDiscount := func(amount int64) models.AppliedDiscount {
+		return models.AppliedDiscount{Scope: models.DiscountScopeOrder, Amount: amount}
+	}
+
+	for _, test := range []struct {
+		name        string
+		includesTax bool
+		calculator  categoryTaxCalculator
+		discounts   []models.AppliedDiscount
+		wantTaxes   []int64
+		wantFinal   int64
+	}{
+		{"tax is added on top", false, standard, nil, []int64{2000, 1000}, 18000},
+		{"tax is extracted from inclusive prices", true, standard, nil, []int64{1667, 833}, 15000}, // 1666.67 and 833.33
+		{"inclusive prices taxed after the order discount", true, standard, []models.AppliedDiscount{orderDiscount(3000)},
+			[]int64{1333, 667}, 12000}, // 8000 and 4000 left
+		{"exclusive prices taxed after a line discount", false, standard, []models.AppliedDiscount{
+			{Scope: models.DiscountScopeLine, ItemID: 2, Amount: 500},
+		}, []int64{2000, 900}, 17400},
+		{"inclusive zero-rated line", true, reduced, nil, []int64{1667, 0}, 15000},
+	} {
+		order := models.Order{PricesIncludeTax: test.includesTax, Items: []models.OrderItem{
+			{ItemID: 1, Quantity: 1, Price: models.NewMoney(10000, "EUR")},
+			{ItemID: 2, Quantity: 2, Price: models.NewMoney(2500, "EUR"), TaxCategory: "food"},
+		}}
+		discounts := models.Discounts{Currency: "EUR", Applied: test.discounts}
+		for _, discount := range test.discounts {
+			discounts.TotalDiscountAmount += discount.Amount
+		}
+		if err := applyTax(nil, &order, discounts, test.calculator); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+
+		var total int64
+		for i, item := range order.Items {
+			if item.Tax != models.NewMoney(test.wantTaxes[i], "EUR") {
+				t.Errorf("%s: line %d tax %s, want %d", test.name, i, item.Tax, test.wantTaxes[i])
+			}
+			total += item.Tax.MinorUnits
+		}
+		if order.TaxTotal != models.NewMoney(total, "EUR") {
+			t.Errorf("%s: tax total %s, want %d", test.name, order.TaxTotal, total)
+		}
+		if order.FinalPrice != models.NewMoney(test.wantFinal, "EUR") {
+			t.Errorf("%s: final price %s, want %d", test.name, order.FinalPrice, test.wantFinal)
+		}
+	}
+}
+
+// shortTaxCalculator forgets the last line
+type shortTaxCalculator struct{}
+
+func (shortTaxCalculator) CalculateTax(_ *gorm.DB, request TaxRequest) ([]LineTax, error) {
+	return make([]LineTax, len(request.Lines)-1), nil
+}
+
+func TestApplyTaxNeedsATaxPerLine(t *testing.T) {
+	order := models.Order{Items: []models.OrderItem{{ItemID: 1, Quantity: 1, Price: models.NewMoney(100, "EUR")}}}
+	err := applyTax(nil, &order, models.Discounts{Currency: "EUR"}, shortTaxCalculator{})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("got %v, want an internal error", err)
+	}
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// DefaultCertReloadInterval is how often certificate files are checked for changes when not configured
+const DefaultCertReloadInterval = 10 * time.Second
+
+// CertReloader serves the certificate and key of the gRPC listener, and optionally verifies client
+// certificates against a CA bundle. It picks up new files without a restart; a change that fails to load
+// keeps the previous certificates.
+type CertReloader struct {
+	CertFile     string
+	KeyFile      string
+	ClientCAFile string             // Verify client certificates against this bundle; empty for plain TLS
+	ClientAuth   tls.ClientAuthType // How client certificates are required when ClientCAFile is set
+
+	current atomic.Pointer[tls.Config]
+	stamps  map[string]time.Time
+}
+
+// NewCertReloader loads the files and fails when they are unusable, so a bad configuration stops startup
+func NewCertReloader(certFile string, keyFile string, clientCAFile string, clientAuth tls.ClientAuthType) (*CertReloader, error) {
+	r := &CertReloader{CertFile: certFile, KeyFile: keyFile, ClientCAFile: clientCAFile, ClientAuth: clientAuth}
+	r.stamps = r.modTimes()
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// files returns the files the reloader reads
+func (r *CertReloader) files() []string {
+	files := []string{r.CertFile, r.KeyFile}
+	if r.ClientCAFile != "" {
+		files = append(files, r.ClientCAFile)
+	}
+	return files
+}
+
+// modTimes returns when each file last changed; files that cannot be read are left out
+func (r *CertReloader) modTimes() map[string]time.Time {
+	stamps := map[string]time.Time{}
+	for _, file := range r.files() {
+		if info, err := os.Stat(file); err == nil {
+			stamps[file] = info.ModTime()
+		}
+	}
+	return stamps
+}
+
+// reload reads the files and swaps in the configuration they make
+func (r *CertReloader) reload() error {
+	certificate, err := tls.LoadX509KeyPair(r.CertFile, r.KeyFile)
+	if err != nil {
+		return fmt.Errorf("loading certificate: %w", err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+		NextProtos:   []string{"h2"},
+	}
+
+	if r.ClientCAFile != "" {
+		bundle, err := os.ReadFile(r.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("reading client CA bundle: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bundle) {
+			return fmt.Errorf("client CA bundle %s holds no PEM certificates", r.ClientCAFile)
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = r.ClientAuth
+	}
+
+	r.current.Store(config)
+	return nil
+}
+
+// TLSConfig returns the configuration for the listener; every handshake uses the latest certificates
+func (r *CertReloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.current.Load(), nil
+		},
+	}
+}
+
+// Watch reloads the files whenever one of them changes, checking every interval until ctx ends
+func (r *CertReloader) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		stamps := r.modTimes()
+		changed := len(stamps) != len(r.stamps)
+		for file, stamp := range stamps {
+			changed = changed || !stamp.Equal(r.stamps[file])
+		}
+		if !changed {
+			continue
+		}
+
+		// Remember the new times either way: a half-written pair is retried once its other file changes
+		r.stamps = stamps
+		if err := r.reload(); err != nil {
+			log.Printf("Failed to reload TLS certificates, keeping the previous ones: %v", err)
+			continue
+		}
+		log.Println("Reloaded TLS certificates")
+	}
+}
+
+// clientCertSubject returns the subject of the verified client certificate of the call, if any
+func clientCertSubject(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	return info.State.VerifiedChains[0][0].Subject.String(), true
+}
@@ -2,58 +2,56 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
 	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"github.com/keyurKalariya/OMS/cmd/oms-api/repository"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // OmsServiceServer implements the gRPC server
 type OmsUserServiceServer struct {
 	pb.UnimplementedUserServiceServer
-	DB *gorm.DB
+	DB         *gorm.DB
+	PageTokens *PageTokenCodec
 }
 
-// var jwtSecret = []byte("your-secret-key")
-
-// // Login generates a JWT token for authenticated users
-// func (s *OmsUserServiceServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
-// 	// Validate user credentials (replace with real validation)
-// 	var user models.User
-// 	err := s.DB.Where("email = ? AND password = ?", req.Email, req.Password).First(&user).Error
-// 	if err != nil {
-// 		return nil, err // Invalid credentials
-// 	}
-
-// 	// Create the JWT claims
-// 	claims := jwt.MapClaims{
-// 		"user_id": user.ID,
-// 		"exp":     time.Now().Add(time.Hour * 1).Unix(), // Token expires in 1 hour
-// 	}
-
-// 	// Generate the token
-// 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-// 	signedToken, err := token.SignedString(jwtSecret)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return &pb.LoginResponse{Token: signedToken}, nil
-// }
-
 func (s *OmsUserServiceServer) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
 	// Initialize a new user from the request data
 	newUser := models.User{
 		Name:  req.GetName(),
 		Email: req.GetEmail(),
+		Role:  models.RoleCustomer,
+	}
+
+	// Store only the hash of the password, if one was given
+	if req.GetPassword() != "" {
+		hash, err := hashPassword(req.GetPassword())
+		if err != nil {
+			return nil, err
+		}
+		newUser.PasswordHash = hash
 	}
 
-	// Insert the new user into the database using GORM
-	if err := s.DB.Create(&newUser).Error; err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to insert user: %v", err)
+	// Insert the new user into the database using GORM, together with its event
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if newUser.PasswordHash != "" {
+			if err := checkLoginEmail(tx, newUser.Email, 0); err != nil {
+				return err
+			}
+		}
+		if err := tx.Create(&newUser).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to insert user: %v", err)
+		}
+		return recordUserEvent(tx, models.EventUserCreated, newUser.ID)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Return the newly created user details in the response using ToPb
@@ -61,10 +59,15 @@ func (s *OmsUserServiceServer) CreateUser(ctx context.Context, req *pb.CreateUse
 }
 
 func (s *OmsUserServiceServer) GetUserById(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	// Customers only see themselves
+	if err := authorizeOwner(ctx, models.PermUsersRead, req.GetUserId()); err != nil {
+		return nil, err
+	}
+
 	// Find the user by ID in the database
 	var user models.User
-	if err := s.DB.Where("id = ? AND deleted_at IS NULL", req.GetUserId()).First(&user).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
+	if err := s.DB.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", req.GetUserId()).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, status.Errorf(codes.NotFound, "User not found")
 		}
 		return nil, status.Errorf(codes.Internal, "Unable to fetch user data: %v", err)
@@ -74,12 +77,25 @@ func (s *OmsUserServiceServer) GetUserById(ctx context.Context, req *pb.GetUserR
 	return user.ToPb(), nil
 }
 
-func (s *OmsUserServiceServer) GetAllUsers(ctx context.Context, req *pb.EmptyRequestUser) (*pb.GetAllUsersResponse, error) {
-	var users []models.User
+// listUsers fetches the page of users matching req that starts at pageToken
+func (s *OmsUserServiceServer) listUsers(ctx context.Context, req *pb.GetAllUsersRequest, pageToken string) ([]models.User, string, error) {
+	// Build the filtered query over non-deleted users
+	query := s.DB.WithContext(ctx).Model(&models.User{})
+	if req.GetEmail() != "" {
+		query = query.Where("LOWER(email) = LOWER(?)", req.GetEmail())
+	}
 
-	// Fetch non-deleted users from the database
-	if err := s.DB.Where("deleted_at IS NULL").Find(&users).Error; err != nil {
-		return nil, status.Errorf(codes.Internal, "Unable to fetch users: %v", err)
+	page := pageRequest{PageSize: req.GetPageSize(), PageToken: pageToken, OrderBy: req.GetOrderBy()}
+	return listPage(query, s.PageTokens, req, page, func(user *models.User) (time.Time, int32) {
+		return user.CreatedAt, user.ID
+	})
+}
+
+func (s *OmsUserServiceServer) GetAllUsers(ctx context.Context, req *pb.GetAllUsersRequest) (*pb.GetAllUsersResponse, error) {
+	// Fetch one page of users
+	users, nextPageToken, err := s.listUsers(ctx, req, req.GetPageToken())
+	if err != nil {
+		return nil, err
 	}
 
 	// Map the users to gRPC response format
@@ -91,16 +107,40 @@ func (s *OmsUserServiceServer) GetAllUsers(ctx context.Context, req *pb.EmptyReq
 			Email:     user.Email,
 			CreatedAt: user.CreatedAt.String(),
 			UpdatedAt: user.UpdatedAt.String(),
+			Role:      user.Role.ToPb(),
 		})
 	}
 
 	// Return the users in the response
 	return &pb.GetAllUsersResponse{
-		Users: userResponses,
+		Users:         userResponses,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
+// StreamUsers sends every user matching the request filters, reading them from the database in batches
+func (s *OmsUserServiceServer) StreamUsers(req *pb.GetAllUsersRequest, stream pb.UserService_StreamUsersServer) error {
+	ctx := stream.Context()
+	return streamPages(ctx, req.GetPageToken(), func(pageToken string) (string, error) {
+		users, nextPageToken, err := s.listUsers(ctx, req, pageToken)
+		if err != nil {
+			return "", err
+		}
+		for _, user := range users {
+			if err := stream.Send(user.ToPb()); err != nil {
+				return "", err
+			}
+		}
+		return nextPageToken, nil
+	})
+}
+
 func (s *OmsUserServiceServer) UpdateUserById(ctx context.Context, req *pb.UpdateUserRequest) (*pb.User, error) {
+	// Customers only update themselves
+	if err := authorizeOwner(ctx, models.PermUsersWrite, req.GetId()); err != nil {
+		return nil, err
+	}
+
 	// Find the user by ID
 	var user models.User
 	if err := s.DB.First(&user, req.GetId()).Error; err != nil {
@@ -113,10 +153,33 @@ func (s *OmsUserServiceServer) UpdateUserById(ctx context.Context, req *pb.Updat
 	// Update user details
 	user.Name = req.GetName()
 	user.Email = req.GetEmail()
+	if req.GetPassword() != "" {
+		hash, err := hashPassword(req.GetPassword())
+		if err != nil {
+			return nil, err
+		}
+		user.PasswordHash = hash
+	}
 
-	// Save the updated user
-	if err := s.DB.Save(&user).Error; err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to update user: %v", err)
+	// Save the updated user; a new password ends every session
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if user.PasswordHash != "" {
+			if err := checkLoginEmail(tx, user.Email, user.ID); err != nil {
+				return err
+			}
+		}
+		if err := tx.Save(&user).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to update user: %v", err)
+		}
+		if req.GetPassword() != "" {
+			if err := revokeSessions(tx, user.ID); err != nil {
+				return err
+			}
+		}
+		return recordUserEvent(tx, models.EventUserUpdated, user.ID)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Return the updated user in the response
@@ -126,6 +189,7 @@ func (s *OmsUserServiceServer) UpdateUserById(ctx context.Context, req *pb.Updat
 		Email:     user.Email,
 		CreatedAt: user.CreatedAt.String(),
 		UpdatedAt: user.UpdatedAt.String(),
+		Role:      user.Role.ToPb(),
 	}, nil
 }
 
@@ -145,8 +209,14 @@ func (s *OmsUserServiceServer) DeleteUserById(ctx context.Context, req *pb.Delet
 
 	// Proceed with soft delete (set deleted_at to the current time)
 	user.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
-	if err := s.DB.Save(&user).Error; err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to delete user: %v", err)
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&user).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to delete user: %v", err)
+		}
+		return recordUserEvent(tx, models.EventUserDeleted, user.ID)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Return success message in the response
@@ -157,9 +227,14 @@ func (s *OmsUserServiceServer) DeleteUserById(ctx context.Context, req *pb.Delet
 func (s *OmsUserServiceServer) GetUserOrdersByUserId(ctx context.Context, req *pb.GetUserRequest) (*pb.UserOrderResponse, error) {
 	id := req.GetUserId() // Retrieve user ID from the gRPC request
 
-	// Fetch user details and associated orders in one query using Preload
+	// Customers only see their own orders
+	if err := authorizeOwner(ctx, models.PermOrdersRead, id); err != nil {
+		return nil, err
+	}
+
+	// Fetch user details
 	var user models.User
-	if err := s.DB.Preload("Orders.Items").First(&user, id).Error; err != nil {
+	if err := s.DB.First(&user, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, status.Errorf(codes.NotFound, "User not found")
 		}
@@ -171,23 +246,33 @@ func (s *OmsUserServiceServer) GetUserOrdersByUserId(ctx context.Context, req *p
 		return nil, status.Errorf(codes.FailedPrecondition, "User has been soft deleted")
 	}
 
+	// Fetch the user's orders and their items in a constant number of queries
+	orders, err := repository.NewOrderReader(s.DB).ListByUser(ctx, user.ID, true)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to fetch user orders: %v", err)
+	}
+
 	// Map orders and their items to response structs
 	var ordersResponse []*pb.OrderResponseu
-	for _, order := range user.Orders {
+	for _, order := range orders {
 		orderResponse := &pb.OrderResponseu{
-			Id:         int32(order.ID),
-			TotalPrice: order.TotalPrice,
-			FinalPrice: order.FinalPrice,
-			Status:     order.Status,
+			Id:              int32(order.ID),
+			TotalPrice:      order.TotalPrice.Major(),
+			FinalPrice:      order.FinalPrice.Major(),
+			Status:          string(order.Status),
+			TotalPriceMoney: order.TotalPrice.ToPb(),
+			FinalPriceMoney: order.FinalPrice.ToPb(),
 		}
 
 		// Map items to response struct
 		var itemsResponse []*pb.ItemResponseu
 		for _, item := range order.Items {
 			itemsResponse = append(itemsResponse, &pb.ItemResponseu{
-				ItemId:   item.ID,
-				Price:    item.Price,
-				Quantity: int32(item.Quantity),
+				ItemId:     item.ItemID,
+				Price:      item.Price.Major(),
+				Quantity:   int32(item.Quantity),
+				ItemName:   item.ItemName,
+				PriceMoney: item.Price.ToPb(),
 			})
 		}
 		orderResponse.Items = itemsResponse
@@ -208,3 +293,43 @@ func (s *OmsUserServiceServer) GetUserOrdersByUserId(ctx context.Context, req *p
 	// Send the final response with user and order details
 	return userResponse, nil
 }
+
+// SetUserRole changes the role of a user; the last admin keeps theirs
+func (s *OmsUserServiceServer) SetUserRole(ctx context.Context, req *pb.SetUserRoleRequest) (*pb.User, error) {
+	role, ok := models.RoleFromPb(req.GetRole())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "A role is required")
+	}
+
+	var user models.User
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the admins, so concurrent changes cannot remove the last one together
+		var admins []int32
+		if err := tx.Model(&models.User{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("role = ?", models.RoleAdmin).Pluck("id", &admins).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to fetch admins: %v", err)
+		}
+
+		// Find the user
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&user, req.GetUserId()).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Errorf(codes.NotFound, "User not found")
+			}
+			return status.Errorf(codes.Internal, "Failed to fetch user: %v", err)
+		}
+		if user.Role == models.RoleAdmin && role != models.RoleAdmin && len(admins) <= 1 {
+			return status.Errorf(codes.FailedPrecondition, "The last admin cannot lose the role")
+		}
+
+		// Change the role
+		if err := tx.Model(&user).Update("role", role).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to update user: %v", err)
+		}
+		return recordUserEvent(tx, models.EventUserUpdated, user.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user.ToPb(), nil
+}
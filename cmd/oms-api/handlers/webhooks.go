@@ -0,0 +1,554 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// DefaultWebhookMaxAttempts is how many times a delivery is tried before it is dead, when not configured
+	DefaultWebhookMaxAttempts = 8
+	// DefaultWebhookTimeout bounds a delivery attempt when no HTTP client is configured
+	DefaultWebhookTimeout = 10 * time.Second
+	// DefaultWebhookBackoff is the wait after the first failed attempt; every further failure doubles it
+	DefaultWebhookBackoff = 10 * time.Second
+	// DefaultWebhookMaxBackoff caps the wait between two attempts
+	DefaultWebhookMaxBackoff = time.Hour
+	// DefaultWebhookConcurrency is how many deliveries are sent at once, when not configured
+	DefaultWebhookConcurrency = 8
+
+	// webhookBatchSize is how many outbox events are queued per transaction
+	webhookBatchSize = 100
+)
+
+// SignWebhook returns the hex HMAC-SHA256 of a delivery, as sent after "sha256=" in X-OMS-Signature.
+// Receivers compute it over the X-OMS-Timestamp header, a "." and the raw body to verify a delivery.
+func SignWebhook(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookDispatcher queues the outbox events for the subscriptions that receive them and delivers them,
+// retrying failed deliveries with exponential backoff
+type WebhookDispatcher struct {
+	DB          *gorm.DB
+	Client      *http.Client  // Sends the deliveries; a client timing out after DefaultWebhookTimeout when nil
+	MaxAttempts int32         // Attempts before a delivery is dead; DefaultWebhookMaxAttempts when zero
+	Backoff     time.Duration // Wait after the first failed attempt; DefaultWebhookBackoff when zero
+	MaxBackoff  time.Duration // Longest wait between attempts; DefaultWebhookMaxBackoff when zero
+	Concurrency int           // Deliveries sent at once; DefaultWebhookConcurrency when zero
+}
+
+func (d *WebhookDispatcher) client() *http.Client {
+	if d.Client == nil {
+		return &http.Client{Timeout: DefaultWebhookTimeout}
+	}
+	return d.Client
+}
+
+func (d *WebhookDispatcher) concurrency() int {
+	if d.Concurrency <= 0 {
+		return DefaultWebhookConcurrency
+	}
+	return d.Concurrency
+}
+
+// claimLease returns how long a claimed delivery is left to its dispatcher; it outlasts an attempt
+func (d *WebhookDispatcher) claimLease() time.Duration {
+	timeout := d.client().Timeout
+	if timeout <= 0 {
+		timeout = DefaultWebhookTimeout
+	}
+	return 2 * timeout
+}
+
+func (d *WebhookDispatcher) maxAttempts() int32 {
+	if d.MaxAttempts <= 0 {
+		return DefaultWebhookMaxAttempts
+	}
+	return d.MaxAttempts
+}
+
+// backoff returns how long to wait after the given number of failed attempts
+func (d *WebhookDispatcher) backoff(attempts int32) time.Duration {
+	wait, limit := d.Backoff, d.MaxBackoff
+	if wait <= 0 {
+		wait = DefaultWebhookBackoff
+	}
+	if limit <= 0 {
+		limit = DefaultWebhookMaxBackoff
+	}
+	for i := int32(1); i < attempts && wait < limit; i++ {
+		wait *= 2
+	}
+	if wait > limit {
+		return limit
+	}
+	return wait
+}
+
+// QueueEvents creates a delivery for every outbox event not queued yet and every active subscription that
+// receives it. Subscriptions only receive events that happened after they were created. It returns how
+// many deliveries it queued.
+func (d *WebhookDispatcher) QueueEvents(ctx context.Context) (int, error) {
+	if err := sequenceEvents(d.DB.WithContext(ctx)); err != nil {
+		return 0, err
+	}
+	queued := 0
+	for {
+		var deliveries []models.WebhookDelivery
+		var events []models.OutboxEvent
+		err := d.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			// Lock the cursor so events are queued by one process at a time
+			cursor := models.WebhookCursor{ID: 1}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&cursor).Error; err != nil {
+				return err
+			}
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&cursor, cursor.ID).Error; err != nil {
+				return err
+			}
+
+			// Read the events after it
+			if err := tx.Select("sequence", "type", "created_at").Where("sequence > ?", cursor.LastSequence).
+				Order("sequence").Limit(webhookBatchSize).Find(&events).Error; err != nil {
+				return err
+			}
+			if len(events) == 0 {
+				return nil
+			}
+
+			// Queue them for the subscriptions that receive them
+			var subscriptions []models.WebhookSubscription
+			if err := tx.Where("active").Find(&subscriptions).Error; err != nil {
+				return err
+			}
+			now := time.Now()
+			for _, event := range events {
+				for _, subscription := range subscriptions {
+					if !subscription.Receives(event.Type) || event.CreatedAt.Before(subscription.CreatedAt) {
+						continue
+					}
+					deliveries = append(deliveries, models.WebhookDelivery{
+						SubscriptionID: subscription.ID,
+						EventSequence:  *event.Sequence,
+						EventType:      event.Type,
+						Status:         models.WebhookDeliveryPending,
+						NextAttemptAt:  now,
+					})
+				}
+			}
+			if len(deliveries) > 0 {
+				if err := tx.Create(&deliveries).Error; err != nil {
+					return err
+				}
+			}
+
+			// Move the cursor past them
+			return tx.Model(&cursor).Update("last_sequence", *events[len(events)-1].Sequence).Error
+		})
+		if err != nil {
+			return queued, err
+		}
+		queued += len(deliveries)
+		if len(events) < webhookBatchSize {
+			return queued, nil
+		}
+	}
+}
+
+// DeliverDue attempts every pending delivery whose next attempt is due and returns how many attempts it
+// made. Each of Concurrency workers claims one delivery at a time, sends it and records the outcome, so a
+// slow receiver only holds up its own worker. No row stays locked while a receiver answers.
+func (d *WebhookDispatcher) DeliverDue(ctx context.Context) (int, error) {
+	var attempted atomic.Int64
+	var wg sync.WaitGroup
+	errs := make([]error, d.concurrency())
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for {
+				delivery, err := d.claimDue(ctx)
+				if err != nil || delivery == nil {
+					errs[i] = err
+					return
+				}
+				attempted.Add(1)
+				if err := d.attempt(ctx, delivery); err != nil {
+					errs[i] = err
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	return int(attempted.Load()), errors.Join(errs...)
+}
+
+// claimDue claims the most overdue delivery of an active subscription for one attempt by moving its next
+// attempt past the claim lease, so other workers and processes leave it alone. Rows locked by others are
+// skipped. It returns nil when no delivery is due.
+func (d *WebhookDispatcher) claimDue(ctx context.Context) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	found := true
+	err := d.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "webhook_deliveries"}, Options: "SKIP LOCKED"}).
+			Joins("JOIN webhook_subscriptions ON webhook_subscriptions.id = webhook_deliveries.subscription_id AND webhook_subscriptions.active AND webhook_subscriptions.deleted_at IS NULL").
+			Where("webhook_deliveries.status = ? AND webhook_deliveries.next_attempt_at <= ?", models.WebhookDeliveryPending, now).
+			Order("webhook_deliveries.next_attempt_at").Take(&delivery).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			found = false
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return tx.Model(&delivery).Update("next_attempt_at", now.Add(d.claimLease())).Error
+	})
+	if err != nil || !found {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// attempt sends a claimed delivery once, outside any transaction, and records the outcome
+func (d *WebhookDispatcher) attempt(ctx context.Context, delivery *models.WebhookDelivery) error {
+	db := d.DB.WithContext(ctx)
+	var subscription models.WebhookSubscription
+	if err := db.First(&subscription, delivery.SubscriptionID).Error; err != nil {
+		// Deleting the subscription since the claim made the delivery dead
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	// Encode the event as JSON and post it
+	var statusCode int32
+	var outbox models.OutboxEvent
+	err := db.Where("sequence = ?", delivery.EventSequence).First(&outbox).Error
+	if err == nil {
+		var event *pb.Event
+		if event, err = outbox.ToPb(); err == nil {
+			var body []byte
+			if body, err = protojson.Marshal(event); err == nil {
+				statusCode, err = d.send(ctx, &subscription, delivery, body)
+			}
+		}
+	}
+
+	// Record the outcome, unless a replay started the delivery over in the meantime
+	claimed := delivery.Attempts
+	columns := d.outcome(delivery, statusCode, err, time.Now())
+	if columns["status"] == models.WebhookDeliveryDead {
+		log.Printf("Webhook delivery %d to subscription %d is dead after %d attempts: %v", delivery.ID, subscription.ID, delivery.Attempts, err)
+	}
+	return db.Model(&models.WebhookDelivery{}).Where("id = ? AND status = ? AND attempts = ?", delivery.ID, models.WebhookDeliveryPending, claimed).
+		Updates(columns).Error
+}
+
+// outcome counts an attempt of delivery that ended with statusCode and err, and returns the columns
+// recording it: delivered, dead after the last attempt, or pending until the backoff has passed
+func (d *WebhookDispatcher) outcome(delivery *models.WebhookDelivery, statusCode int32, err error, now time.Time) map[string]interface{} {
+	delivery.Attempts++
+	columns := map[string]interface{}{"attempts": delivery.Attempts, "last_status_code": statusCode}
+	switch {
+	case err == nil:
+		columns["status"] = models.WebhookDeliveryDelivered
+		columns["delivered_at"] = now
+		columns["last_error"] = ""
+	case delivery.Attempts >= d.maxAttempts():
+		columns["status"] = models.WebhookDeliveryDead
+		columns["last_error"] = err.Error()
+	default:
+		columns["next_attempt_at"] = now.Add(d.backoff(delivery.Attempts))
+		columns["last_error"] = err.Error()
+	}
+	return columns
+}
+
+// send posts a signed delivery to the subscription's URL and returns the HTTP status. Anything but a 2xx
+// response is an error.
+func (d *WebhookDispatcher) send(ctx context.Context, subscription *models.WebhookSubscription, delivery *models.WebhookDelivery, body []byte) (int32, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-OMS-Delivery-Id", strconv.Itoa(int(delivery.ID)))
+	request.Header.Set("X-OMS-Event-Type", string(delivery.EventType))
+	request.Header.Set("X-OMS-Event-Sequence", strconv.FormatInt(delivery.EventSequence, 10))
+	request.Header.Set("X-OMS-Timestamp", timestamp)
+	request.Header.Set("X-OMS-Signature", "sha256="+SignWebhook(subscription.Secret, timestamp, body))
+
+	response, err := d.client().Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+		return int32(response.StatusCode), nil
+	}
+	snippet, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+	return int32(response.StatusCode), fmt.Errorf("receiver answered %s: %s", response.Status, strings.TrimSpace(string(snippet)))
+}
+
+// Run queues and delivers events every interval until ctx ends
+func (d *WebhookDispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := d.QueueEvents(ctx); err != nil {
+			log.Printf("Failed to queue webhook deliveries: %v", err)
+		}
+		if _, err := d.DeliverDue(ctx); err != nil {
+			log.Printf("Failed to send webhook deliveries: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// WebhookServiceServer implements the gRPC WebhookService
+type WebhookServiceServer struct {
+	pb.UnimplementedWebhookServiceServer
+	DB *gorm.DB
+}
+
+// webhookURL validates the URL of a subscription
+func webhookURL(value string) (string, error) {
+	target, err := url.Parse(strings.TrimSpace(value))
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return "", status.Errorf(codes.InvalidArgument, "An http or https URL is required")
+	}
+	return target.String(), nil
+}
+
+// webhookEventTypes validates the event types of a subscription and joins them for storage; no types
+// stand for every order status change
+func webhookEventTypes(values []string) (string, error) {
+	types := models.OrderStatusEventTypes
+	if len(values) > 0 {
+		types = nil
+		for _, value := range values {
+			eventType := models.EventType(strings.TrimSpace(value))
+			known := false
+			for _, candidate := range models.EventTypes {
+				known = known || candidate == eventType
+			}
+			if !known {
+				return "", status.Errorf(codes.InvalidArgument, "Unknown event type: %q", value)
+			}
+			types = append(types, eventType)
+		}
+	}
+	joined := make([]string, len(types))
+	for i, eventType := range types {
+		joined[i] = string(eventType)
+	}
+	return strings.Join(joined, ","), nil
+}
+
+// CreateWebhookSubscription adds an active subscription. The response is the only one carrying the secret.
+func (s *WebhookServiceServer) CreateWebhookSubscription(ctx context.Context, req *pb.WebhookSubscription) (*pb.WebhookSubscription, error) {
+	target, err := webhookURL(req.GetUrl())
+	if err != nil {
+		return nil, err
+	}
+	types, err := webhookEventTypes(req.GetEventTypes())
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate a secret unless one was given
+	secret := req.GetSecret()
+	if secret == "" {
+		random := make([]byte, 32)
+		if _, err := rand.Read(random); err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to generate secret: %v", err)
+		}
+		secret = hex.EncodeToString(random)
+	}
+
+	subscription := models.WebhookSubscription{URL: target, EventTypes: types, Secret: secret, Active: true}
+	if err := s.DB.WithContext(ctx).Create(&subscription).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to insert webhook subscription: %v", err)
+	}
+
+	response := subscription.ToPb()
+	response.Secret = secret
+	return response, nil
+}
+
+// UpdateWebhookSubscription changes the fields given in the request and leaves the omitted ones as they are
+func (s *WebhookServiceServer) UpdateWebhookSubscription(ctx context.Context, req *pb.WebhookSubscription) (*pb.WebhookSubscription, error) {
+	var subscription models.WebhookSubscription
+	if err := s.DB.WithContext(ctx).First(&subscription, req.GetId()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Webhook subscription not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to fetch webhook subscription: %v", err)
+	}
+
+	if req.GetUrl() != "" {
+		target, err := webhookURL(req.GetUrl())
+		if err != nil {
+			return nil, err
+		}
+		subscription.URL = target
+	}
+	if len(req.GetEventTypes()) > 0 {
+		types, err := webhookEventTypes(req.GetEventTypes())
+		if err != nil {
+			return nil, err
+		}
+		subscription.EventTypes = types
+	}
+	if req.Active != nil {
+		subscription.Active = req.GetActive()
+	}
+	if req.GetSecret() != "" {
+		subscription.Secret = req.GetSecret()
+	}
+	if err := s.DB.WithContext(ctx).Save(&subscription).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to update webhook subscription: %v", err)
+	}
+	return subscription.ToPb(), nil
+}
+
+// DeleteWebhookSubscription removes a subscription; its pending deliveries are dead
+func (s *WebhookServiceServer) DeleteWebhookSubscription(ctx context.Context, req *pb.DeleteWebhookSubscriptionRequest) (*pb.DeleteWebhookSubscriptionResponse, error) {
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&models.WebhookSubscription{}, req.GetSubscriptionId())
+		if result.Error != nil {
+			return status.Errorf(codes.Internal, "Failed to delete webhook subscription: %v", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return status.Errorf(codes.NotFound, "Webhook subscription not found")
+		}
+
+		if err := tx.Model(&models.WebhookDelivery{}).Where("subscription_id = ? AND status = ?", req.GetSubscriptionId(), models.WebhookDeliveryPending).
+			Updates(map[string]interface{}{"status": models.WebhookDeliveryDead, "last_error": "Subscription deleted"}).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to update webhook deliveries: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteWebhookSubscriptionResponse{Message: "Webhook subscription deleted successfully"}, nil
+}
+
+func (s *WebhookServiceServer) GetAllWebhookSubscriptions(ctx context.Context, req *pb.GetAllWebhookSubscriptionsRequest) (*pb.GetAllWebhookSubscriptionsResponse, error) {
+	var subscriptions []models.WebhookSubscription
+	if err := s.DB.WithContext(ctx).Order("id").Find(&subscriptions).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to fetch webhook subscriptions: %v", err)
+	}
+
+	response := &pb.GetAllWebhookSubscriptionsResponse{}
+	for i := range subscriptions {
+		response.Subscriptions = append(response.Subscriptions, subscriptions[i].ToPb())
+	}
+	return response, nil
+}
+
+// GetWebhookDeliveries returns the most recent deliveries, optionally of one subscription and in one status
+func (s *WebhookServiceServer) GetWebhookDeliveries(ctx context.Context, req *pb.GetWebhookDeliveriesRequest) (*pb.GetWebhookDeliveriesResponse, error) {
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+	query := s.DB.WithContext(ctx).Order("id DESC").Limit(limit)
+	if req.GetSubscriptionId() != 0 {
+		query = query.Where("subscription_id = ?", req.GetSubscriptionId())
+	}
+	if req.GetStatus() != pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED {
+		deliveryStatus, ok := models.WebhookDeliveryStatusFromPb(req.GetStatus())
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown delivery status")
+		}
+		query = query.Where("status = ?", deliveryStatus)
+	}
+
+	var deliveries []models.WebhookDelivery
+	if err := query.Find(&deliveries).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to fetch webhook deliveries: %v", err)
+	}
+
+	response := &pb.GetWebhookDeliveriesResponse{}
+	for i := range deliveries {
+		response.Deliveries = append(response.Deliveries, deliveries[i].ToPb())
+	}
+	return response, nil
+}
+
+// ReplayWebhookDeliveries queues a delivery, or every dead delivery of a subscription, again with a fresh
+// set of attempts
+func (s *WebhookServiceServer) ReplayWebhookDeliveries(ctx context.Context, req *pb.ReplayWebhookDeliveriesRequest) (*pb.GetWebhookDeliveriesResponse, error) {
+	// The replayed rows are returned into deliveries
+	var deliveries []models.WebhookDelivery
+	query := s.DB.WithContext(ctx).Model(&deliveries)
+	switch {
+	case req.GetDeliveryId() != 0 && req.GetSubscriptionId() != 0:
+		return nil, status.Errorf(codes.InvalidArgument, "Give either a delivery ID or a subscription ID, not both")
+	case req.GetDeliveryId() != 0:
+		query = query.Where("id = ? AND status <> ?", req.GetDeliveryId(), models.WebhookDeliveryPending)
+	case req.GetSubscriptionId() != 0:
+		query = query.Where("subscription_id = ? AND status = ?", req.GetSubscriptionId(), models.WebhookDeliveryDead)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "A delivery ID or a subscription ID is required")
+	}
+
+	result := query.Clauses(clause.Returning{}).Updates(map[string]interface{}{
+		"status":          models.WebhookDeliveryPending,
+		"attempts":        0,
+		"next_attempt_at": time.Now(),
+		"delivered_at":    nil,
+	})
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to replay webhook deliveries: %v", result.Error)
+	}
+	if result.RowsAffected == 0 && req.GetDeliveryId() != 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Webhook delivery %d does not exist or is still pending", req.GetDeliveryId())
+	}
+
+	response := &pb.GetWebhookDeliveriesResponse{}
+	for i := range deliveries {
+		response.Deliveries = append(response.Deliveries, deliveries[i].ToPb())
+	}
+	return response, nil
+}
@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+)
+
+// receivedWebhook is a request as seen by a test receiver
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+// newReceiver starts a webhook receiver answering with statusCode and the requests it got on received
+func newReceiver(t *testing.T, statusCode int, answer string) (*httptest.Server, <-chan receivedWebhook) {
+	t.Helper()
+	received := make(chan receivedWebhook, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- receivedWebhook{header: r.Header.Clone(), body: body}
+		w.WriteHeader(statusCode)
+		io.WriteString(w, answer)
+	}))
+	t.Cleanup(server.Close)
+	return server, received
+}
+
+func TestSignWebhook(t *testing.T) {
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(`1700000000.{"sequence":"1"}`))
+	want := hex.EncodeToString(mac.Sum(nil))
+
+	if got := SignWebhook("secret", "1700000000", []byte(`{"sequence":"1"}`)); got != want {
+		t.Errorf("SignWebhook = %s, want %s", got, want)
+	}
+	if got := SignWebhook("other", "1700000000", []byte(`{"sequence":"1"}`)); got == want {
+		t.Error("SignWebhook gave the same signature for another secret")
+	}
+	if got := SignWebhook("secret", "1700000001", []byte(`{"sequence":"1"}`)); got == want {
+		t.Error("SignWebhook gave the same signature for another timestamp")
+	}
+}
+
+func TestWebhookSendSignsDelivery(t *testing.T) {
+	server, received := newReceiver(t, http.StatusNoContent, "")
+	d := &WebhookDispatcher{Client: server.Client()}
+	subscription := &models.WebhookSubscription{ID: 3, URL: server.URL, Secret: "secret"}
+	delivery := &models.WebhookDelivery{ID: 7, SubscriptionID: 3, EventSequence: 42, EventType: models.EventType("order.shipped")}
+	body := []byte(`{"sequence":"42"}`)
+
+	statusCode, err := d.send(context.Background(), subscription, delivery, body)
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if statusCode != http.StatusNoContent {
+		t.Errorf("status code = %d, want %d", statusCode, http.StatusNoContent)
+	}
+
+	request := <-received
+	if string(request.body) != string(body) {
+		t.Errorf("body = %s, want %s", request.body, body)
+	}
+	for name, want := range map[string]string{
+		"Content-Type":         "application/json",
+		"X-OMS-Delivery-Id":    "7",
+		"X-OMS-Event-Type":     "order.shipped",
+		"X-OMS-Event-Sequence": "42",
+	} {
+		if got := request.header.Get(name); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+
+	// The receiver verifies the signature with the secret, the timestamp header and the raw body
+	timestamp := request.header.Get("X-OMS-Timestamp")
+	if timestamp == "" {
+		t.Fatal("X-OMS-Timestamp is missing")
+	}
+	mac := hmac.New(sha256.New, []byte(subscription.Secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(request.body)
+	if got, want := request.header.Get("X-OMS-Signature"), "sha256="+hex.EncodeToString(mac.Sum(nil)); got != want {
+		t.Errorf("X-OMS-Signature = %q, want %q", got, want)
+	}
+}
+
+func TestWebhookSendFailsOnErrorStatus(t *testing.T) {
+	server, _ := newReceiver(t, http.StatusServiceUnavailable, "try again later\n")
+	d := &WebhookDispatcher{Client: server.Client()}
+	subscription := &models.WebhookSubscription{URL: server.URL, Secret: "secret"}
+
+	statusCode, err := d.send(context.Background(), subscription, &models.WebhookDelivery{}, []byte(`{}`))
+	if err == nil {
+		t.Fatal("send succeeded on a 503")
+	}
+	if statusCode != http.StatusServiceUnavailable {
+		t.Errorf("status code = %d, want %d", statusCode, http.StatusServiceUnavailable)
+	}
+	if !strings.Contains(err.Error(), "503") || !strings.Contains(err.Error(), "try again later") {
+		t.Errorf("error %q names neither the status nor the answer", err)
+	}
+}
+
+func TestWebhookSendTimesOut(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+
+	client := server.Client()
+	client.Timeout = 50 * time.Millisecond
+	d := &WebhookDispatcher{Client: client}
+	subscription := &models.WebhookSubscription{URL: server.URL, Secret: "secret"}
+
+	if _, err := d.send(context.Background(), subscription, &models.WebhookDelivery{}, []byte(`{}`)); err == nil {
+		t.Fatal("send succeeded against a receiver that never answers")
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	d := &WebhookDispatcher{Backoff: time.Second, MaxBackoff: 5 * time.Second}
+	for attempts, want := range map[int32]time.Duration{
+		1:  time.Second,
+		2:  2 * time.Second,
+		3:  4 * time.Second,
+		4:  5 * time.Second,
+		30: 5 * time.Second,
+	} {
+		if got := d.backoff(attempts); got != want {
+			t.Errorf("backoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+
+	defaults := &WebhookDispatcher{}
+	if got := defaults.backoff(1); got != DefaultWebhookBackoff {
+		t.Errorf("default backoff(1) = %v, want %v", got, DefaultWebhookBackoff)
+	}
+	if got := defaults.backoff(100); got != DefaultWebhookMaxBackoff {
+		t.Errorf("default backoff(100) = %v, want %v", got, DefaultWebhookMaxBackoff)
+	}
+}
+
+func TestWebhookOutcome(t *testing.T) {
+	d := &WebhookDispatcher{MaxAttempts: 3, Backoff: time.Second, MaxBackoff: time.Minute}
+	now := time.Now()
+	failure := errors.New("receiver answered 500 Internal Server Error")
+
+	// Failed attempts stay pending until the last one, which is dead
+	delivery := &models.WebhookDelivery{Status: models.WebhookDeliveryPending}
+	for attempt := int32(1); attempt <= 3; attempt++ {
+		columns := d.outcome(delivery, http.StatusInternalServerError, failure, now)
+		if columns["attempts"] != attempt {
+			t.Errorf("attempt %d: attempts = %v", attempt, columns["attempts"])
+		}
+		if columns["last_error"] != failure.Error() {
+			t.Errorf("attempt %d: last_error = %v", attempt, columns["last_error"])
+		}
+		if attempt < 3 {
+			if _, ok := columns["status"]; ok {
+				t.Errorf("attempt %d: status = %v, want it left pending", attempt, columns["status"])
+			}
+			if want := now.Add(d.backoff(attempt)); columns["next_attempt_at"] != want {
+				t.Errorf("attempt %d: next_attempt_at = %v, want %v", attempt, columns["next_attempt_at"], want)
+			}
+			continue
+		}
+		if columns["status"] != models.WebhookDeliveryDead {
+			t.Errorf("attempt %d: status = %v, want %v", attempt, columns["status"], models.WebhookDeliveryDead)
+		}
+		if _, ok := columns["next_attempt_at"]; ok {
+			t.Errorf("attempt %d: a dead delivery got a next attempt", attempt)
+		}
+	}
+
+	// A successful attempt is delivered
+	delivery = &models.WebhookDelivery{Status: models.WebhookDeliveryPending, Attempts: 1}
+	columns := d.outcome(delivery, http.StatusOK, nil, now)
+	if columns["status"] != models.WebhookDeliveryDelivered || columns["delivered_at"] != now || columns["last_error"] != "" {
+		t.Errorf("success recorded as %v", columns)
+	}
+	if columns["attempts"] != int32(2) {
+		t.Errorf("attempts = %v, want 2", columns["attempts"])
+	}
+}
+
+func TestWebhookEventTypes(t *testing.T) {
+	for _, test := range []struct {
+		values []string
+		want   string
+		fails  bool
+	}{
+		{nil, "order.confirmed,order.cancelled,order.status_changed", false},
+		{[]string{"order.created", " item.deleted "}, "order.created,item.deleted", false},
+		{[]string{"order.created", "order.exploded"}, "", true},
+	} {
+		got, err := webhookEventTypes(test.values)
+		if (err != nil) != test.fails {
+			t.Errorf("webhookEventTypes(%q) error = %v, want failure %v", test.values, err, test.fails)
+		}
+		if got != test.want {
+			t.Errorf("webhookEventTypes(%q) = %q, want %q", test.values, got, test.want)
+		}
+	}
+
+	for value, valid := range map[string]bool{"https://example.com/hooks": true, "http://localhost:8080": true, "ftp://example.com": false, "": false, "https://": false} {
+		if _, err := webhookURL(value); (err == nil) != valid {
+			t.Errorf("webhookURL(%q) error = %v, want valid %v", value, err, valid)
+		}
+	}
+}
@@ -1,17 +1,24 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
 	"fmt"
 	"log"
+	"math"
 	"net"
+	"net/http"
 	"os"
 	"os/exec"
+	"strconv"
 	"time"
 
 	"github.com/keyurKalariya/OMS/cmd/oms-api/handlers"
 	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
 	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -66,15 +73,103 @@ func initDB() (*gorm.DB, error) {
 		return nil, err
 	}
 
-	err = db.AutoMigrate(&models.Item{}, &models.User{}, &models.Order{}, &models.OrderItem{}, &models.UserOrder{})
+	err = db.AutoMigrate(&models.Item{}, &models.User{}, &models.Order{}, &models.OrderItem{}, &models.UserOrder{}, &models.OrderStatusHistory{}, &models.StockMovement{}, &models.IdempotencyKey{}, &models.DiscountRule{}, &models.Coupon{}, &models.CouponItem{}, &models.CouponRedemption{}, &models.OrderAdjustment{}, &models.ItemPrice{}, &models.ExchangeRate{}, &models.OrderExchangeRate{}, &models.TaxJurisdiction{}, &models.TaxRate{}, &models.Address{}, &models.OrderAddress{}, &models.Shipment{}, &models.ShipmentItem{}, &models.Return{}, &models.ReturnLine{}, &models.Payment{}, &models.PaymentAttempt{}, &models.CheckoutSaga{}, &models.CheckoutStep{}, &models.OutboxEvent{}, &models.WebhookSubscription{}, &models.WebhookDelivery{}, &models.WebhookCursor{}, &models.Session{}, &models.APIKey{})
 	if err != nil {
 		return nil, err
 	}
 
+	// Older releases stored amounts as plain numbers in major units
+	if err := migrateMoneyColumns(db); err != nil {
+		return nil, err
+	}
+
+	// Older releases wrote 'Confirm' for confirmed orders; bring them in line with the lifecycle
+	if err := db.Unscoped().Model(&models.Order{}).Where("status = ?", "Confirm").Update("status", models.OrderStatusConfirmed).Error; err != nil {
+		return nil, err
+	}
+
+	// Seed the discount rules that used to be hard-coded, unless rules were ever configured
+	var ruleCount int64
+	if err := db.Unscoped().Model(&models.DiscountRule{}).Count(&ruleCount).Error; err != nil {
+		return nil, err
+	}
+	if ruleCount == 0 {
+		defaultRules := models.DefaultDiscountRules()
+		if err := db.Create(&defaultRules).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	// Orders priced before adjustments were persisted get one adjustment explaining their whole discount
+	if err := db.Exec(`INSERT INTO order_adjustments (order_id, name, kind, scope, amount_minor_units, amount_currency, created_at)
+		SELECT o.id, 'Discount before itemised adjustments', ?, ?, o.total_price_minor_units - o.final_price_minor_units, o.total_price_currency, NOW()
+		FROM orders o
+		WHERE o.total_price_minor_units > o.final_price_minor_units
+		AND NOT EXISTS (SELECT 1 FROM order_adjustments a WHERE a.order_id = o.id)`,
+		models.DiscountKindFixed, models.DiscountScopeOrder).Error; err != nil {
+		return nil, err
+	}
+
+	// Orders priced before tax was calculated carry zero tax and discount shares in their own currency
+	if err := db.Exec(`UPDATE orders SET tax_total_minor_units = 0, tax_total_currency = total_price_currency
+		WHERE tax_total_currency IS NULL OR tax_total_currency = ''`).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Exec(`UPDATE order_items SET tax_minor_units = 0, tax_currency = price_currency,
+		discount_minor_units = COALESCE(discount_minor_units, 0), discount_currency = price_currency
+		WHERE tax_currency IS NULL OR tax_currency = ''`).Error; err != nil {
+		return nil, err
+	}
+
 	log.Println("Connected to the PostgreSQL database using GORM v2")
 	return db, nil
 }
 
+// migrateMoneyColumns moves amounts stored as major-unit numbers into the minor-unit and currency columns
+// of models.Money, taking them to be in the default currency, and drops the old columns. Fixed discount
+// rules and coupons move their value into their amount. Every step is skipped once it has run.
+func migrateMoneyColumns(db *gorm.DB) error {
+	factor := math.Pow10(models.CurrencyExponent(models.DefaultCurrency))
+	columns := []struct {
+		model  interface{}
+		table  string
+		column string
+		prefix string
+	}{
+		{&models.Item{}, "items", "price", "price_"},
+		{&models.Order{}, "orders", "total_price", "total_price_"},
+		{&models.Order{}, "orders", "final_price", "final_price_"},
+		{&models.OrderItem{}, "order_items", "price", "price_"},
+		{&models.OrderAdjustment{}, "order_adjustments", "amount", "amount_"},
+		{&models.Coupon{}, "coupons", "min_order_value", "min_order_value_"},
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, c := range columns {
+			if !tx.Migrator().HasColumn(c.model, c.column) {
+				continue
+			}
+			log.Printf("Migrating %s.%s to minor units of %s", c.table, c.column, models.DefaultCurrency)
+			if err := tx.Exec(fmt.Sprintf("UPDATE %s SET %sminor_units = ROUND(%s * ?)::bigint, %scurrency = ? WHERE %s IS NOT NULL",
+				c.table, c.prefix, c.column, c.prefix, c.column), factor, models.DefaultCurrency).Error; err != nil {
+				return err
+			}
+			if err := tx.Migrator().DropColumn(c.model, c.column); err != nil {
+				return err
+			}
+		}
+
+		for _, table := range []string{"discount_rules", "coupons"} {
+			if err := tx.Exec(fmt.Sprintf(`UPDATE %s SET amount_minor_units = ROUND(value * ?)::bigint, amount_currency = ?, value = 0
+				WHERE kind = ? AND (amount_currency IS NULL OR amount_currency = '')`, table),
+				factor, models.DefaultCurrency, models.DiscountKindFixed).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -83,6 +178,13 @@ func getEnv(key, defaultValue string) string {
 }
 
 func main() {
+	// Amounts that predate currency codes, and amounts sent through deprecated numeric fields, are in the default currency
+	defaultCurrency, err := models.NormalizeCurrency(getEnv("DEFAULT_CURRENCY", models.DefaultCurrency))
+	if err != nil {
+		log.Fatalf("Invalid DEFAULT_CURRENCY: %v", err)
+	}
+	models.DefaultCurrency = defaultCurrency
+
 	// Initialize the database
 	db, err := initDB()
 	if err != nil {
@@ -90,6 +192,13 @@ func main() {
 	}
 
 	log.Println("Database connection initialized successfully")
+
+	// New users are customers; the first admin comes from ADMIN_EMAIL and assigns every other role
+	if adminEmail := getEnv("ADMIN_EMAIL", ""); adminEmail != "" {
+		if err := handlers.BootstrapAdmin(db, adminEmail, getEnv("ADMIN_PASSWORD", "")); err != nil {
+			log.Fatalf("Failed to set up the admin: %v", err)
+		}
+	}
 	log.Print("<=========================================================>")
 	log.Print("<==================== Starting OMS ====================>")
 	log.Print("<=========================================================>")
@@ -100,22 +209,228 @@ func main() {
 		log.Fatalf("Failed to create listener: %s", err)
 	}
 
-	// Initialize gRPC server
-	grpcServer := grpc.NewServer()
+	// Access tokens are signed with the first AUTH_SIGNING_KEYS key and verified with any of them, so a new
+	// key can be put first while the previous one keeps verifying until its tokens expire. Without keys a
+	// random one is used, which logs everyone out on restart and does not work across replicas.
+	var signingKeys []handlers.SigningKey
+	if value := getEnv("AUTH_SIGNING_KEYS", ""); value != "" {
+		if signingKeys, err = handlers.ParseSigningKeys(value); err != nil {
+			log.Fatalf("Invalid AUTH_SIGNING_KEYS: %v", err)
+		}
+	} else {
+		log.Println("AUTH_SIGNING_KEYS is not set; using a random signing key")
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			log.Fatalf("Failed to generate signing key: %v", err)
+		}
+		signingKeys = []handlers.SigningKey{{ID: "random", Secret: secret}}
+	}
+	accessTokenTTL, err := time.ParseDuration(getEnv("ACCESS_TOKEN_TTL", handlers.DefaultAccessTokenTTL.String()))
+	if err != nil {
+		log.Fatalf("Invalid ACCESS_TOKEN_TTL: %v", err)
+	}
+	refreshTokenTTL, err := time.ParseDuration(getEnv("REFRESH_TOKEN_TTL", handlers.DefaultRefreshTokenTTL.String()))
+	if err != nil {
+		log.Fatalf("Invalid REFRESH_TOKEN_TTL: %v", err)
+	}
+	tokens := handlers.NewTokenIssuer(signingKeys, accessTokenTTL, refreshTokenTTL)
+	authenticator := &handlers.Authenticator{DB: db, Tokens: tokens}
+
+	// The listener serves TLS when TLS_CERT_FILE and TLS_KEY_FILE are set, and verifies client certificates
+	// against TLS_CLIENT_CA_FILE when that is set too (mutual TLS). Changed files are picked up without a
+	// restart, so certificates can be rotated in place.
+	var certReloader *handlers.CertReloader
+	certFile, keyFile := getEnv("TLS_CERT_FILE", ""), getEnv("TLS_KEY_FILE", "")
+	if certFile != "" || keyFile != "" {
+		clientAuth := tls.RequireAndVerifyClientCert
+		switch mode := getEnv("TLS_CLIENT_AUTH", "require"); mode {
+		case "require":
+		case "optional":
+			clientAuth = tls.VerifyClientCertIfGiven
+		default:
+			log.Fatalf("Invalid TLS_CLIENT_AUTH: %q", mode)
+		}
+		clientCAFile := getEnv("TLS_CLIENT_CA_FILE", "")
+		if certReloader, err = handlers.NewCertReloader(certFile, keyFile, clientCAFile, clientAuth); err != nil {
+			log.Fatalf("Invalid TLS configuration: %v", err)
+		}
+		reloadInterval, err := time.ParseDuration(getEnv("TLS_RELOAD_INTERVAL", handlers.DefaultCertReloadInterval.String()))
+		if err != nil || reloadInterval <= 0 {
+			log.Fatalf("Invalid TLS_RELOAD_INTERVAL: %q", getEnv("TLS_RELOAD_INTERVAL", ""))
+		}
+		go certReloader.Watch(context.Background(), reloadInterval)
+
+		// A verified client certificate identifies the caller by its subject. By default it grants no
+		// role, so an access token or API key is still required; TLS_CLIENT_CERT_ROLE opts in to one.
+		if clientCAFile != "" {
+			if value := getEnv("TLS_CLIENT_CERT_ROLE", "none"); value != "none" {
+				role, ok := models.ParseRole(value)
+				if !ok {
+					log.Fatalf("Invalid TLS_CLIENT_CERT_ROLE: %q", value)
+				}
+				authenticator.CertRole = role
+			}
+		}
+	}
+
+	// Initialize gRPC server; every RPC but logging in and signing up needs an access token or API key,
+	// and a role or scopes the access policy allows to call it
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(authenticator.UnaryInterceptor, handlers.AuthorizeUnary),
+		grpc.ChainStreamInterceptor(authenticator.StreamInterceptor, handlers.AuthorizeStream),
+	}
+	if certReloader != nil {
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(certReloader.TLSConfig())))
+	}
+	grpcServer := grpc.NewServer(serverOptions...)
 
 	// Enable gRPC reflection
 	reflection.Register(grpcServer)
 
+	// Page tokens are signed so clients cannot forge them. Without a configured secret a random one is used,
+	// which invalidates outstanding tokens on restart and does not work across replicas.
+	pageTokenSecret := []byte(getEnv("PAGE_TOKEN_SECRET", ""))
+	if len(pageTokenSecret) == 0 {
+		log.Println("PAGE_TOKEN_SECRET is not set; using a random page token secret")
+		pageTokenSecret = make([]byte, 32)
+		if _, err := rand.Read(pageTokenSecret); err != nil {
+			log.Fatalf("Failed to generate page token secret: %v", err)
+		}
+	}
+	pageTokens := handlers.NewPageTokenCodec(pageTokenSecret)
+
 	// Register services
-	omsItemService := &handlers.OmsItemServiceServer{DB: db}
+	omsItemService := &handlers.OmsItemServiceServer{DB: db, PageTokens: pageTokens}
 	pb.RegisterOmsItemServiceServer(grpcServer, omsItemService)
 
-	omsUserService := &handlers.OmsUserServiceServer{DB: db}
+	omsUserService := &handlers.OmsUserServiceServer{DB: db, PageTokens: pageTokens}
 	pb.RegisterUserServiceServer(grpcServer, omsUserService)
 
-	omsOrderService := &handlers.OrderServiceServer{DB: db}
+	omsAuthService := &handlers.AuthServiceServer{DB: db, Tokens: tokens}
+	pb.RegisterAuthServiceServer(grpcServer, omsAuthService)
+
+	omsAPIKeyService := &handlers.APIKeyServiceServer{DB: db}
+	pb.RegisterApiKeyServiceServer(grpcServer, omsAPIKeyService)
+
+	// Only the in-process fake payment provider exists so far; it answers as FAKE_PAYMENT_OUTCOME says
+	paymentTimeout, err := time.ParseDuration(getEnv("PAYMENT_TIMEOUT", handlers.DefaultPaymentTimeout.String()))
+	if err != nil {
+		log.Fatalf("Invalid PAYMENT_TIMEOUT: %v", err)
+	}
+	fakePaymentOutcome, err := handlers.ParseFakePaymentOutcome(getEnv("FAKE_PAYMENT_OUTCOME", string(handlers.FakePaymentSucceed)))
+	if err != nil {
+		log.Fatalf("Invalid FAKE_PAYMENT_OUTCOME: %v", err)
+	}
+	omsPaymentService := &handlers.PaymentServiceServer{
+		DB:       db,
+		Provider: &handlers.FakePaymentProvider{Outcome: fakePaymentOutcome},
+		Timeout:  paymentTimeout,
+	}
+	pb.RegisterPaymentServiceServer(grpcServer, omsPaymentService)
+
+	idempotencyTTL, err := time.ParseDuration(getEnv("IDEMPOTENCY_KEY_TTL", handlers.DefaultIdempotencyTTL.String()))
+	if err != nil {
+		log.Fatalf("Invalid IDEMPOTENCY_KEY_TTL: %v", err)
+	}
+	pricesIncludeTax, err := strconv.ParseBool(getEnv("PRICES_INCLUDE_TAX", "false"))
+	if err != nil {
+		log.Fatalf("Invalid PRICES_INCLUDE_TAX: %v", err)
+	}
+	omsOrderService := &handlers.OrderServiceServer{
+		DB:               db,
+		PageTokens:       pageTokens,
+		IdempotencyTTL:   idempotencyTTL,
+		Tax:              handlers.TableTaxCalculator{},
+		PricesIncludeTax: pricesIncludeTax,
+		Payments:         omsPaymentService,
+	}
 	pb.RegisterOrderServiceServer(grpcServer, omsOrderService)
 
+	omsDiscountService := &handlers.DiscountServiceServer{DB: db}
+	pb.RegisterDiscountServiceServer(grpcServer, omsDiscountService)
+
+	omsCurrencyService := &handlers.CurrencyServiceServer{DB: db}
+	pb.RegisterCurrencyServiceServer(grpcServer, omsCurrencyService)
+
+	omsTaxService := &handlers.TaxServiceServer{DB: db}
+	pb.RegisterTaxServiceServer(grpcServer, omsTaxService)
+
+	omsFulfillmentService := &handlers.FulfillmentServiceServer{DB: db}
+	pb.RegisterFulfillmentServiceServer(grpcServer, omsFulfillmentService)
+
+	omsReturnService := &handlers.ReturnServiceServer{DB: db, Payments: omsPaymentService}
+	pb.RegisterReturnServiceServer(grpcServer, omsReturnService)
+
+	eventPollInterval, err := time.ParseDuration(getEnv("EVENT_POLL_INTERVAL", handlers.DefaultEventPollInterval.String()))
+	if err != nil {
+		log.Fatalf("Invalid EVENT_POLL_INTERVAL: %v", err)
+	}
+	omsEventService := &handlers.EventServiceServer{DB: db, PollInterval: eventPollInterval}
+	pb.RegisterEventServiceServer(grpcServer, omsEventService)
+
+	omsWebhookService := &handlers.WebhookServiceServer{DB: db}
+	pb.RegisterWebhookServiceServer(grpcServer, omsWebhookService)
+
+	webhookMaxAttempts, err := strconv.Atoi(getEnv("WEBHOOK_MAX_ATTEMPTS", strconv.Itoa(handlers.DefaultWebhookMaxAttempts)))
+	if err != nil || webhookMaxAttempts < 1 {
+		log.Fatalf("Invalid WEBHOOK_MAX_ATTEMPTS: %q", getEnv("WEBHOOK_MAX_ATTEMPTS", ""))
+	}
+	webhookTimeout, err := time.ParseDuration(getEnv("WEBHOOK_TIMEOUT", handlers.DefaultWebhookTimeout.String()))
+	if err != nil {
+		log.Fatalf("Invalid WEBHOOK_TIMEOUT: %v", err)
+	}
+	webhookBackoff, err := time.ParseDuration(getEnv("WEBHOOK_BACKOFF", handlers.DefaultWebhookBackoff.String()))
+	if err != nil {
+		log.Fatalf("Invalid WEBHOOK_BACKOFF: %v", err)
+	}
+	webhookMaxBackoff, err := time.ParseDuration(getEnv("WEBHOOK_MAX_BACKOFF", handlers.DefaultWebhookMaxBackoff.String()))
+	if err != nil {
+		log.Fatalf("Invalid WEBHOOK_MAX_BACKOFF: %v", err)
+	}
+	webhookConcurrency, err := strconv.Atoi(getEnv("WEBHOOK_CONCURRENCY", strconv.Itoa(handlers.DefaultWebhookConcurrency)))
+	if err != nil || webhookConcurrency < 1 {
+		log.Fatalf("Invalid WEBHOOK_CONCURRENCY: %q", getEnv("WEBHOOK_CONCURRENCY", ""))
+	}
+	webhookDispatcher := &handlers.WebhookDispatcher{
+		DB:          db,
+		Client:      &http.Client{Timeout: webhookTimeout},
+		MaxAttempts: int32(webhookMaxAttempts),
+		Backoff:     webhookBackoff,
+		MaxBackoff:  webhookMaxBackoff,
+		Concurrency: webhookConcurrency,
+	}
+
+	// Every RPC must have an access policy
+	if err := handlers.CheckPolicy(grpcServer); err != nil {
+		log.Fatalf("Invalid access policy: %v", err)
+	}
+
+	// Periodically remove idempotency keys whose TTL has passed
+	go func() {
+		for range time.Tick(time.Hour) {
+			if purged, err := handlers.PurgeExpiredIdempotencyKeys(db); err != nil {
+				log.Printf("Failed to purge expired idempotency keys: %v", err)
+			} else if purged > 0 {
+				log.Printf("Purged %d expired idempotency keys", purged)
+			}
+		}
+	}()
+
+	// Resume checkouts a previous run left unfinished, and any that stop advancing later
+	go func() {
+		for {
+			if resumed, err := omsOrderService.ResumeCheckouts(context.Background()); err != nil {
+				log.Printf("Failed to resume checkouts: %v", err)
+			} else if resumed > 0 {
+				log.Printf("Resumed %d checkouts", resumed)
+			}
+			time.Sleep(time.Minute)
+		}
+	}()
+
+	// Deliver the outbox events to the webhook subscriptions
+	go webhookDispatcher.Run(context.Background(), eventPollInterval)
+
 	// Start gRPC server in a goroutine
 	go func() {
 		log.Printf("Starting gRPC server on port %s", grpcPort)
@@ -131,7 +446,20 @@ func main() {
 			grpcHost := getEnv("GRPC_HOST", "localhost")
 			// Bind to 0.0.0.0 to make it accessible from outside the container
 			log.Printf("Starting grpcui on http://0.0.0.0:%s", grpcuiPort)
-			grpcuiCmd := exec.Command("grpcui", "-plaintext", "-bind", "0.0.0.0", "-port", grpcuiPort, grpcHost+grpcPort)
+			// Against a TLS listener grpcui verifies the server with GRPCUI_CA_FILE, or skips verification
+			// without it, and presents GRPCUI_CERT_FILE and GRPCUI_KEY_FILE when client certificates are required
+			args := []string{"-plaintext"}
+			if certReloader != nil {
+				args = []string{"-insecure"}
+				if caFile := getEnv("GRPCUI_CA_FILE", ""); caFile != "" {
+					args = []string{"-cacert", caFile}
+				}
+				if certFile, keyFile := getEnv("GRPCUI_CERT_FILE", ""), getEnv("GRPCUI_KEY_FILE", ""); certFile != "" && keyFile != "" {
+					args = append(args, "-cert", certFile, "-key", keyFile)
+				}
+			}
+			args = append(args, "-bind", "0.0.0.0", "-port", grpcuiPort, grpcHost+grpcPort)
+			grpcuiCmd := exec.Command("grpcui", args...)
 			grpcuiCmd.Stdout = os.Stdout
 			grpcuiCmd.Stderr = os.Stderr
 
@@ -0,0 +1,126 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"gorm.io/gorm"
+)
+
+// PostalAddress holds the fields of an address shared by the address book and the copies kept on orders
+type PostalAddress struct {
+	RecipientName string `json:"recipient_name"`
+	Line1         string `json:"line1"`
+	Line2         string `json:"line2"`
+	City          string `json:"city"`
+	Region        string `json:"region"` // State or province code, e.g. "CA"
+	PostalCode    string `json:"postal_code"`
+	CountryCode   string `json:"country_code" gorm:"size:2"` // ISO 3166-1 alpha-2
+	Phone         string `json:"phone"`
+}
+
+// TaxRegion returns the region orders shipped to the address are taxed in, e.g. "US-CA", or the country
+// code alone when the address has no region
+func (a *PostalAddress) TaxRegion() string {
+	if a.Region == "" {
+		return a.CountryCode
+	}
+	return NormalizeTaxRegion(a.CountryCode + "-" + a.Region)
+}
+
+// PostalAddressFromPb validates the fields of a protobuf Address and converts them to the model
+func PostalAddressFromPb(address *pb.Address) (PostalAddress, error) {
+	postal := PostalAddress{
+		RecipientName: strings.TrimSpace(address.GetRecipientName()),
+		Line1:         strings.TrimSpace(address.GetLine1()),
+		Line2:         strings.TrimSpace(address.GetLine2()),
+		City:          strings.TrimSpace(address.GetCity()),
+		Region:        strings.ToUpper(strings.TrimSpace(address.GetRegion())),
+		PostalCode:    strings.TrimSpace(address.GetPostalCode()),
+		CountryCode:   strings.ToUpper(strings.TrimSpace(address.GetCountryCode())),
+		Phone:         strings.TrimSpace(address.GetPhone()),
+	}
+	if postal.RecipientName == "" || postal.Line1 == "" || postal.City == "" {
+		return postal, fmt.Errorf("recipient_name, line1 and city are required")
+	}
+	if len(postal.CountryCode) != 2 || postal.CountryCode[0] < 'A' || postal.CountryCode[0] > 'Z' || postal.CountryCode[1] < 'A' || postal.CountryCode[1] > 'Z' {
+		return postal, fmt.Errorf("country_code must be a two-letter ISO 3166-1 code")
+	}
+	return postal, nil
+}
+
+// toPb fills the postal fields of a protobuf Address
+func (a *PostalAddress) toPb(address *pb.Address) *pb.Address {
+	address.RecipientName = a.RecipientName
+	address.Line1 = a.Line1
+	address.Line2 = a.Line2
+	address.City = a.City
+	address.Region = a.Region
+	address.PostalCode = a.PostalCode
+	address.CountryCode = a.CountryCode
+	address.Phone = a.Phone
+	return address
+}
+
+// Address is an entry of a user's address book
+type Address struct {
+	ID              int32  `json:"id"`
+	UserID          int32  `json:"user_id" gorm:"index"`
+	Label           string `json:"label"`
+	PostalAddress   `gorm:"embedded"`
+	DefaultShipping bool           `json:"default_shipping"` // At most one per user
+	DefaultBilling  bool           `json:"default_billing"`  // At most one per user
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `json:"deleted_at"`
+}
+
+// ToPb converts the Address model to the protobuf Address
+func (a *Address) ToPb() *pb.Address {
+	return a.PostalAddress.toPb(&pb.Address{
+		Id:              a.ID,
+		UserId:          a.UserID,
+		Label:           a.Label,
+		DefaultShipping: a.DefaultShipping,
+		DefaultBilling:  a.DefaultBilling,
+		CreatedAt:       a.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       a.UpdatedAt.Format(time.RFC3339),
+	})
+}
+
+// OrderAddressKind says what an order address is used for
+type OrderAddressKind string
+
+const (
+	OrderAddressShipping OrderAddressKind = "shipping"
+	OrderAddressBilling  OrderAddressKind = "billing"
+)
+
+// OrderAddress is the copy of an address an order was placed with. It is never updated, so edits to the
+// address book do not change orders already placed.
+type OrderAddress struct {
+	ID            int32            `json:"id"`
+	OrderID       int32            `json:"order_id" gorm:"index"`
+	Kind          OrderAddressKind `json:"kind"`
+	AddressID     *int32           `json:"address_id"` // Address book entry it was copied from; nil for inline addresses
+	Label         string           `json:"label"`
+	PostalAddress `gorm:"embedded"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// OrderAddressFrom copies an address book entry for an order
+func OrderAddressFrom(kind OrderAddressKind, address *Address) OrderAddress {
+	addressID := address.ID
+	return OrderAddress{Kind: kind, AddressID: &addressID, Label: address.Label, PostalAddress: address.PostalAddress}
+}
+
+// ToPb converts the OrderAddress model to the protobuf Address
+func (a *OrderAddress) ToPb() *pb.Address {
+	address := &pb.Address{Label: a.Label, CreatedAt: a.CreatedAt.Format(time.RFC3339)}
+	if a.AddressID != nil {
+		address.Id = *a.AddressID
+	}
+	return a.PostalAddress.toPb(address)
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+)
+
+// APIKey lets another system call the OMS with the permissions in its scopes. Only the SHA-256 hash of
+// the key is stored.
+type APIKey struct {
+	ID         int32      `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	KeyHash    string     `json:"-" gorm:"uniqueIndex"`
+	Scopes     string     `json:"scopes"` // Comma-separated permissions
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedBy  int32      `json:"created_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// Permissions returns the scopes of the key
+func (k *APIKey) Permissions() []Permission {
+	var permissions []Permission
+	for _, value := range strings.Split(k.Scopes, ",") {
+		if value != "" {
+			permissions = append(permissions, Permission(value))
+		}
+	}
+	return permissions
+}
+
+// Active reports whether the key can be used at now
+func (k *APIKey) Active(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// ToPb converts the APIKey model to the protobuf ApiKey
+func (k *APIKey) ToPb() *pb.ApiKey {
+	response := &pb.ApiKey{
+		Id:        k.ID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		CreatedBy: k.CreatedBy,
+		CreatedAt: k.CreatedAt.Format(time.RFC3339),
+	}
+	for _, permission := range k.Permissions() {
+		response.Scopes = append(response.Scopes, string(permission))
+	}
+	if k.ExpiresAt != nil {
+		response.ExpiresAt = k.ExpiresAt.Format(time.RFC3339)
+	}
+	if k.LastUsedAt != nil {
+		response.LastUsedAt = k.LastUsedAt.Format(time.RFC3339)
+	}
+	if k.RevokedAt != nil {
+		response.RevokedAt = k.RevokedAt.Format(time.RFC3339)
+	}
+	return response
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAPIKeyActive(t *testing.T) {
+	now := time.Date(2024, 12, 10, 12, 0, 0, 0, time.UTC)
+	earlier, later := now.Add(-time.Hour), now.Add(time.Hour)
+	for _, test := range []struct {
+		name string
+		key  APIKey
+		want bool
+	}{
+		{"without expiry", APIKey{}, true},
+		{"before expiry", APIKey{ExpiresAt: &later}, true},
+		{"at expiry", APIKey{ExpiresAt: &now}, false},
+		{"after expiry", APIKey{ExpiresAt: &earlier}, false},
+		{"revoked", APIKey{RevokedAt: &earlier}, false},
+		{"revoked before expiry", APIKey{ExpiresAt: &later, RevokedAt: &earlier}, false},
+	} {
+		if got := test.key.Active(now); got != test.want {
+			t.Errorf("%s: Active = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestAPIKeyPermissions(t *testing.T) {
+	for scopes, want := range map[string][]Permission{
+		"":                        nil,
+		"orders.read":             {PermOrdersRead},
+		"orders.read,items.write": {PermOrdersRead, PermItemsWrite},
+		"orders.read,,":           {PermOrdersRead},
+	} {
+		key := APIKey{Scopes: scopes}
+		if got := key.Permissions(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Permissions of %q = %v, want %v", scopes, got, want)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package models
+
+import (
+	"time"
+
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+)
+
+// CheckoutStatus is the state of a checkout saga
+type CheckoutStatus string
+
+const (
+	CheckoutStatusRunning      CheckoutStatus = "Running"      // Working through the checkout steps
+	CheckoutStatusCompensating CheckoutStatus = "Compensating" // A step failed; undoing what earlier steps did
+	CheckoutStatusCompleted    CheckoutStatus = "Completed"
+	CheckoutStatusFailed       CheckoutStatus = "Failed" // Compensated; the order is left as it was found
+)
+
+// IsActive reports whether a saga in status s still has steps to run
+func (s CheckoutStatus) IsActive() bool {
+	return s == CheckoutStatusRunning || s == CheckoutStatusCompensating
+}
+
+var checkoutStatusToPb = map[CheckoutStatus]pb.CheckoutStatus{
+	CheckoutStatusRunning:      pb.CheckoutStatus_CHECKOUT_STATUS_RUNNING,
+	CheckoutStatusCompensating: pb.CheckoutStatus_CHECKOUT_STATUS_COMPENSATING,
+	CheckoutStatusCompleted:    pb.CheckoutStatus_CHECKOUT_STATUS_COMPLETED,
+	CheckoutStatusFailed:       pb.CheckoutStatus_CHECKOUT_STATUS_FAILED,
+}
+
+// CheckoutStepName names a step of the checkout saga
+type CheckoutStepName string
+
+const (
+	CheckoutStepReserveStock     CheckoutStepName = "reserve_stock"
+	CheckoutStepAuthorizePayment CheckoutStepName = "authorize_payment"
+	CheckoutStepCapturePayment   CheckoutStepName = "capture_payment"
+	CheckoutStepConfirmOrder     CheckoutStepName = "confirm_order"
+
+	// Compensations, run in this order once a step failed
+	CheckoutStepRefundPayment CheckoutStepName = "refund_payment"
+	CheckoutStepVoidPayment   CheckoutStepName = "void_payment"
+	CheckoutStepReleaseStock  CheckoutStepName = "release_stock"
+)
+
+// CheckoutSteps lists the steps of a checkout in the order they run
+var CheckoutSteps = []CheckoutStepName{CheckoutStepReserveStock, CheckoutStepAuthorizePayment, CheckoutStepCapturePayment, CheckoutStepConfirmOrder}
+
+// CheckoutCompensations lists the compensations of a failed checkout in the order they run
+var CheckoutCompensations = []CheckoutStepName{CheckoutStepRefundPayment, CheckoutStepVoidPayment, CheckoutStepReleaseStock}
+
+// NextCheckoutStep returns the step of plan after step, or "" when step is the last one
+func NextCheckoutStep(plan []CheckoutStepName, step CheckoutStepName) CheckoutStepName {
+	for i := range plan {
+		if plan[i] == step && i+1 < len(plan) {
+			return plan[i+1]
+		}
+	}
+	return ""
+}
+
+// CheckoutStepResult is the outcome of a step run by a checkout saga
+type CheckoutStepResult string
+
+const (
+	CheckoutStepDone    CheckoutStepResult = "done"
+	CheckoutStepSkipped CheckoutStepResult = "skipped" // Nothing to do, e.g. stock was reserved before the checkout
+	CheckoutStepFailed  CheckoutStepResult = "failed"
+)
+
+// CheckoutSaga coordinates the confirmation of an order: reserving its stock, authorizing and capturing its
+// payment and confirming it. Its progress is persisted after every step so it resumes after a restart.
+// Only the effects the saga itself caused are compensated when a step fails.
+type CheckoutSaga struct {
+	ID                int32            `json:"id"`
+	OrderID           int32            `json:"order_id" gorm:"index"`
+	Status            CheckoutStatus   `json:"status"`
+	Step              CheckoutStepName `json:"step"`                // Next step to run; empty once the saga has finished
+	PaymentMethod     string           `json:"payment_method"`      // Used when the order has no authorized payment yet
+	ReservedStock     bool             `json:"reserved_stock"`      // The saga reserved the stock and must release it on failure
+	PaymentID         *int32           `json:"payment_id"`          // Payment the saga authorized and must void on failure
+	CapturedPaymentID *int32           `json:"captured_payment_id"` // Payment the saga captured and must refund on failure
+	Error             string           `json:"error"`               // Why the failed step failed
+	Steps             []CheckoutStep   `json:"steps" gorm:"foreignKey:SagaID"`
+	CreatedAt         time.Time        `json:"created_at"`
+	UpdatedAt         time.Time        `json:"updated_at"`
+}
+
+// CheckoutStep records a step a checkout saga ran and its outcome
+type CheckoutStep struct {
+	ID        int32              `json:"id"`
+	SagaID    int32              `json:"saga_id" gorm:"index"`
+	Name      CheckoutStepName   `json:"name"`
+	Result    CheckoutStepResult `json:"result"`
+	Detail    string             `json:"detail"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+// ToPb converts the CheckoutSaga model and its loaded steps to the protobuf CheckoutSaga
+func (c *CheckoutSaga) ToPb() *pb.CheckoutSaga {
+	response := &pb.CheckoutSaga{
+		Id:        c.ID,
+		OrderId:   c.OrderID,
+		Status:    checkoutStatusToPb[c.Status],
+		NextStep:  string(c.Step),
+		Error:     c.Error,
+		CreatedAt: c.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: c.UpdatedAt.Format(time.RFC3339),
+	}
+	if c.PaymentID != nil {
+		response.PaymentId = *c.PaymentID
+	}
+	for _, step := range c.Steps {
+		response.Steps = append(response.Steps, &pb.CheckoutStep{
+			Name:      string(step.Name),
+			Result:    string(step.Result),
+			Detail:    step.Detail,
+			CreatedAt: step.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return response
+}
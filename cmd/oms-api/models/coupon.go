@@ -0,0 +1,179 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"gorm.io/gorm"
+)
+
+// Coupon is a promo code customers can redeem on an order
+type Coupon struct {
+	ID                    int32          `json:"id"`
+	Code                  string         `json:"code" gorm:"uniqueIndex"` // Stored upper case
+	Kind                  DiscountKind   `json:"kind"`
+	Value                 float64        `json:"value"`                                         // Percentage coupons only
+	Amount                Money          `json:"amount" gorm:"embedded;embeddedPrefix:amount_"` // Fixed coupons only
+	StartsAt              *time.Time     `json:"starts_at"`
+	EndsAt                *time.Time     `json:"ends_at"`
+	MaxRedemptions        int32          `json:"max_redemptions"`                                                 // 0 for unlimited
+	MaxRedemptionsPerUser int32          `json:"max_redemptions_per_user"`                                        // 0 for unlimited
+	MinOrderValue         Money          `json:"min_order_value" gorm:"embedded;embeddedPrefix:min_order_value_"` // Zero for no minimum
+	RedemptionCount       int32          `json:"redemption_count"`
+	Active                bool           `json:"active"`
+	EligibleItems         []CouponItem   `json:"eligible_items" gorm:"foreignKey:CouponID"` // Empty means every item
+	CreatedAt             time.Time      `json:"created_at"`
+	UpdatedAt             time.Time      `json:"updated_at"`
+	DeletedAt             gorm.DeletedAt `json:"deleted_at"`
+}
+
+// CouponItem makes an item eligible for a coupon
+type CouponItem struct {
+	CouponID int32 `json:"coupon_id" gorm:"primaryKey"`
+	ItemID   int32 `json:"item_id" gorm:"primaryKey"`
+}
+
+// CouponRedemption records a coupon used on an order
+type CouponRedemption struct {
+	ID        int32     `json:"id"`
+	CouponID  int32     `json:"coupon_id" gorm:"index"`
+	UserID    int32     `json:"user_id" gorm:"index"`
+	OrderID   int32     `json:"order_id" gorm:"index"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NormalizeCouponCode returns the stored form of a coupon code
+func NormalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// IsItemEligible reports whether the coupon discounts the given item
+func (c *Coupon) IsItemEligible(itemID int32) bool {
+	if len(c.EligibleItems) == 0 {
+		return true
+	}
+	for _, item := range c.EligibleItems {
+		if item.ItemID == itemID {
+			return true
+		}
+	}
+	return false
+}
+
+// Describe explains in words what the coupon grants
+func (c *Coupon) Describe() string {
+	target := "the order"
+	if len(c.EligibleItems) > 0 {
+		target = "eligible items"
+	}
+	description := fmt.Sprintf("%g%% off %s", c.Value, target)
+	if c.Kind == DiscountKindFixed {
+		description = fmt.Sprintf("%s off %s", c.Amount, target)
+	}
+	if c.MinOrderValue.MinorUnits > 0 {
+		description += fmt.Sprintf(" (order value of at least %s)", c.MinOrderValue)
+	}
+	return "Coupon " + c.Code + ": " + description
+}
+
+// ToPb converts the Coupon model to the protobuf Coupon
+func (c *Coupon) ToPb() *pb.Coupon {
+	coupon := &pb.Coupon{
+		Id:                    c.ID,
+		Code:                  c.Code,
+		Kind:                  discountKindToPb[c.Kind],
+		Value:                 c.Value,
+		MaxRedemptions:        c.MaxRedemptions,
+		MaxRedemptionsPerUser: c.MaxRedemptionsPerUser,
+		MinOrderValue:         c.MinOrderValue.Major(),
+		Active:                c.Active,
+		RedemptionCount:       c.RedemptionCount,
+		CreatedAt:             c.CreatedAt.Format(time.RFC3339),
+	}
+	if c.Kind == DiscountKindFixed {
+		coupon.Value = c.Amount.Major()
+		coupon.Amount = c.Amount.ToPb()
+	}
+	if c.MinOrderValue.MinorUnits > 0 {
+		coupon.MinOrderValueMoney = c.MinOrderValue.ToPb()
+	}
+	if c.StartsAt != nil {
+		coupon.StartsAt = c.StartsAt.Format(time.RFC3339)
+	}
+	if c.EndsAt != nil {
+		coupon.EndsAt = c.EndsAt.Format(time.RFC3339)
+	}
+	for _, item := range c.EligibleItems {
+		coupon.EligibleItemIds = append(coupon.EligibleItemIds, item.ItemID)
+	}
+	return coupon
+}
+
+// CouponFromPb validates a protobuf Coupon and converts it to the model. The id, redemption count and
+// timestamps are not copied.
+func CouponFromPb(coupon *pb.Coupon) (Coupon, error) {
+	if coupon == nil || NormalizeCouponCode(coupon.GetCode()) == "" {
+		return Coupon{}, fmt.Errorf("coupon code is required")
+	}
+
+	result := Coupon{
+		Code:                  NormalizeCouponCode(coupon.GetCode()),
+		Value:                 coupon.GetValue(),
+		MaxRedemptions:        coupon.GetMaxRedemptions(),
+		MaxRedemptionsPerUser: coupon.GetMaxRedemptionsPerUser(),
+		Active:                coupon.GetActive(),
+	}
+
+	for kind, value := range discountKindToPb {
+		if value == coupon.GetKind() {
+			result.Kind = kind
+		}
+	}
+	if result.Kind == "" {
+		return Coupon{}, fmt.Errorf("coupon kind is required")
+	}
+	amount, err := discountAmountFromPb(result.Kind, coupon.GetValue(), coupon.GetAmount())
+	if err != nil {
+		return Coupon{}, fmt.Errorf("coupon %v", err)
+	}
+	if result.Kind == DiscountKindFixed {
+		result.Value = 0
+		result.Amount = amount
+	}
+
+	// The minimum order value falls back to the deprecated amount in the default currency
+	switch {
+	case coupon.GetMinOrderValueMoney() != nil:
+		if result.MinOrderValue, err = MoneyFromPb(coupon.GetMinOrderValueMoney()); err != nil {
+			return Coupon{}, fmt.Errorf("min_order_value_money: %v", err)
+		}
+	case coupon.GetMinOrderValue() != 0:
+		result.MinOrderValue = MoneyFromMajor(coupon.GetMinOrderValue(), DefaultCurrency)
+	}
+	if result.MaxRedemptions < 0 || result.MaxRedemptionsPerUser < 0 || result.MinOrderValue.MinorUnits < 0 {
+		return Coupon{}, fmt.Errorf("coupon limits cannot be negative")
+	}
+
+	if startsAt := coupon.GetStartsAt(); startsAt != "" {
+		parsed, err := time.Parse(time.RFC3339, startsAt)
+		if err != nil {
+			return Coupon{}, fmt.Errorf("starts_at must be an RFC 3339 timestamp")
+		}
+		result.StartsAt = &parsed
+	}
+	if endsAt := coupon.GetEndsAt(); endsAt != "" {
+		parsed, err := time.Parse(time.RFC3339, endsAt)
+		if err != nil {
+			return Coupon{}, fmt.Errorf("ends_at must be an RFC 3339 timestamp")
+		}
+		result.EndsAt = &parsed
+	}
+
+	for _, itemID := range coupon.GetEligibleItemIds() {
+		result.EligibleItems = append(result.EligibleItems, CouponItem{ItemID: itemID})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,101 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+)
+
+// ItemPrice is an explicit price of an item in a currency other than its base price's
+type ItemPrice struct {
+	ItemID     int32     `json:"item_id" gorm:"primaryKey"`
+	Currency   string    `json:"currency" gorm:"primaryKey;size:3"`
+	MinorUnits int64     `json:"minor_units"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Money returns the price as an amount
+func (p *ItemPrice) Money() Money {
+	return NewMoney(p.MinorUnits, p.Currency)
+}
+
+// ExchangeRate converts amounts from BaseCurrency into QuoteCurrency
+type ExchangeRate struct {
+	BaseCurrency  string    `json:"base_currency" gorm:"primaryKey;size:3"`
+	QuoteCurrency string    `json:"quote_currency" gorm:"primaryKey;size:3"`
+	Rate          float64   `json:"rate"` // Units of QuoteCurrency per unit of BaseCurrency
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Convert converts an amount in BaseCurrency into QuoteCurrency, rounding half away from zero to the minor
+// unit of QuoteCurrency
+func (r *ExchangeRate) Convert(amount Money) Money {
+	scale := math.Pow10(CurrencyExponent(r.QuoteCurrency) - CurrencyExponent(r.BaseCurrency))
+	return NewMoney(int64(math.Round(float64(amount.MinorUnits)*r.Rate*scale)), r.QuoteCurrency)
+}
+
+// Inverse returns the rate converting QuoteCurrency back into BaseCurrency
+func (r *ExchangeRate) Inverse() ExchangeRate {
+	return ExchangeRate{
+		BaseCurrency:  r.QuoteCurrency,
+		QuoteCurrency: r.BaseCurrency,
+		Rate:          1 / r.Rate,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
+
+// ToPb converts the ExchangeRate model to the protobuf ExchangeRate
+func (r *ExchangeRate) ToPb() *pb.ExchangeRate {
+	return &pb.ExchangeRate{
+		BaseCurrency:  r.BaseCurrency,
+		QuoteCurrency: r.QuoteCurrency,
+		Rate:          r.Rate,
+		UpdatedAt:     r.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// ExchangeRateFromPb validates a protobuf ExchangeRate and converts it to the model
+func ExchangeRateFromPb(rate *pb.ExchangeRate) (ExchangeRate, error) {
+	base, err := NormalizeCurrency(rate.GetBaseCurrency())
+	if err != nil {
+		return ExchangeRate{}, fmt.Errorf("base_currency: %v", err)
+	}
+	quote, err := NormalizeCurrency(rate.GetQuoteCurrency())
+	if err != nil {
+		return ExchangeRate{}, fmt.Errorf("quote_currency: %v", err)
+	}
+	if base == quote {
+		return ExchangeRate{}, fmt.Errorf("base and quote currency must differ")
+	}
+	if rate.GetRate() <= 0 || math.IsInf(rate.GetRate(), 0) || math.IsNaN(rate.GetRate()) {
+		return ExchangeRate{}, fmt.Errorf("rate must be a positive number")
+	}
+	return ExchangeRate{BaseCurrency: base, QuoteCurrency: quote, Rate: rate.GetRate()}, nil
+}
+
+// OrderExchangeRate snapshots an exchange rate an order was priced with, so its totals can be explained
+// after the rate table has moved on
+type OrderExchangeRate struct {
+	ID            int32     `json:"id"`
+	OrderID       int32     `json:"order_id" gorm:"index"`
+	BaseCurrency  string    `json:"base_currency" gorm:"size:3"`
+	QuoteCurrency string    `json:"quote_currency" gorm:"size:3"`
+	Rate          float64   `json:"rate"`
+	RateUpdatedAt time.Time `json:"rate_updated_at"` // When the rate was last set in the rate table
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ToPb converts the snapshot to the protobuf ExchangeRate
+func (r *OrderExchangeRate) ToPb() *pb.ExchangeRate {
+	return &pb.ExchangeRate{
+		BaseCurrency:  r.BaseCurrency,
+		QuoteCurrency: r.QuoteCurrency,
+		Rate:          r.Rate,
+		UpdatedAt:     r.RateUpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"testing"
+
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+)
+
+func TestExchangeRateConvert(t *testing.T) {
+	for _, test := range []struct {
+		rate   ExchangeRate
+		amount Money
+		want   Money
+	}{
+		{ExchangeRate{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: 0.9}, NewMoney(1999, "USD"), NewMoney(1799, "EUR")},    // 17.991
+		{ExchangeRate{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: 0.5}, NewMoney(1, "USD"), NewMoney(1, "EUR")},          // 0.5 rounds away from zero
+		{ExchangeRate{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: 0.5}, NewMoney(-1, "USD"), NewMoney(-1, "EUR")},        // and so does -0.5
+		{ExchangeRate{BaseCurrency: "USD", QuoteCurrency: "JPY", Rate: 150.25}, NewMoney(1000, "USD"), NewMoney(1503, "JPY")}, // 1502.5 yen
+		{ExchangeRate{BaseCurrency: "JPY", QuoteCurrency: "USD", Rate: 0.0067}, NewMoney(1500, "JPY"), NewMoney(1005, "USD")},
+		{ExchangeRate{BaseCurrency: "USD", QuoteCurrency: "KWD", Rate: 0.3075}, NewMoney(1000, "USD"), NewMoney(3075, "KWD")},
+	} {
+		if got := test.rate.Convert(test.amount); got != test.want {
+			t.Errorf("%s at %s->%s %v = %s, want %s", test.amount, test.rate.BaseCurrency, test.rate.QuoteCurrency, test.rate.Rate, got, test.want)
+		}
+	}
+}
+
+func TestExchangeRateInverse(t *testing.T) {
+	rate := ExchangeRate{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: 1.25}
+	inverse := rate.Inverse()
+	if inverse.BaseCurrency != "USD" || inverse.QuoteCurrency != "EUR" || inverse.Rate != 0.8 {
+		t.Errorf("Inverse = %+v, want USD->EUR at 0.8", inverse)
+	}
+	if got := inverse.Convert(NewMoney(1000, "USD")); got != NewMoney(800, "EUR") {
+		t.Errorf("inverse converts 10 USD to %s, want 8.00 EUR", got)
+	}
+}
+
+func TestExchangeRateFromPb(t *testing.T) {
+	rate, err := ExchangeRateFromPb(&pb.ExchangeRate{BaseCurrency: " usd", QuoteCurrency: "eur", Rate: 0.9})
+	if err != nil {
+		t.Fatalf("ExchangeRateFromPb: %v", err)
+	}
+	if rate.BaseCurrency != "USD" || rate.QuoteCurrency != "EUR" || rate.Rate != 0.9 {
+		t.Errorf("ExchangeRateFromPb = %+v", rate)
+	}
+
+	for _, invalid := range []*pb.ExchangeRate{
+		{BaseCurrency: "US", QuoteCurrency: "EUR", Rate: 1},
+		{BaseCurrency: "USD", QuoteCurrency: "EU1", Rate: 1},
+		{BaseCurrency: "USD", QuoteCurrency: "usd", Rate: 1},
+		{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: 0},
+		{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: -1},
+	} {
+		if _, err := ExchangeRateFromPb(invalid); err == nil {
+			t.Errorf("ExchangeRateFromPb(%v) succeeded", invalid)
+		}
+	}
+}
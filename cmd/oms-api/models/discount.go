@@ -1,10 +1,332 @@
 package models
 
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"gorm.io/gorm"
+)
+
+// DiscountKind says how a rule is applied
+type DiscountKind string
+
+const (
+	DiscountKindPercentage DiscountKind = "percentage" // Value is a percentage, e.g. 15 for 15%
+	DiscountKindFixed      DiscountKind = "fixed"      // Amount is taken off the order, or off each unit for line rules
+)
+
+// DiscountScope says whether a rule discounts the whole order or each matching line
+type DiscountScope string
+
+const (
+	DiscountScopeOrder DiscountScope = "order"
+	DiscountScopeLine  DiscountScope = "line"
+)
+
+// DiscountStacking says whether a rule may be combined with other rules
+type DiscountStacking string
+
+const (
+	DiscountStackingStackable DiscountStacking = "stackable" // Applies together with other stackable rules
+	DiscountStackingExclusive DiscountStacking = "exclusive" // Applies alone, and only if no higher priority rule applied
+)
+
+// DiscountRule is a discount definition evaluated by calculateDiscounts. Zero-valued conditions do not
+// restrict when the rule applies.
+type DiscountRule struct {
+	ID       int32            `json:"id"`
+	Name     string           `json:"name"`
+	Kind     DiscountKind     `json:"kind"`
+	Scope    DiscountScope    `json:"scope"`
+	Value    float64          `json:"value"`                                         // Percentage rules only
+	Amount   Money            `json:"amount" gorm:"embedded;embeddedPrefix:amount_"` // Fixed rules only; applies to orders in its currency
+	Priority int32            `json:"priority"`                                      // Higher priority rules are evaluated first
+	Stacking DiscountStacking `json:"stacking"`
+	Active   bool             `json:"active"`
+
+	StartsAt       *time.Time `json:"starts_at"`
+	EndsAt         *time.Time `json:"ends_at"`
+	RecurringStart string     `json:"recurring_start"` // "MM-DD", first day of a window repeating every year
+	RecurringEnd   string     `json:"recurring_end"`   // "MM-DD", last day of the yearly window
+	MinQuantity    int32      `json:"min_quantity"`    // Line rules: line quantity. Order rules: total quantity
+	MinOrderCount  int32      `json:"min_order_count"` // Orders the customer has placed before
+	UserID         *int32     `json:"user_id"`         // Only for this customer
+	ItemID         *int32     `json:"item_id"`         // Line rules: only this item. Order rules: order must contain it
+
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"deleted_at"`
+}
+
+// ActiveAt reports whether the date conditions of the rule hold at t
+func (r *DiscountRule) ActiveAt(t time.Time) bool {
+	if r.StartsAt != nil && t.Before(*r.StartsAt) {
+		return false
+	}
+	if r.EndsAt != nil && t.After(*r.EndsAt) {
+		return false
+	}
+	if r.RecurringStart != "" && r.RecurringEnd != "" {
+		day := t.Format("01-02")
+		if r.RecurringStart <= r.RecurringEnd {
+			return day >= r.RecurringStart && day <= r.RecurringEnd
+		}
+		// The window wraps around the new year, e.g. 12-20 to 01-05
+		return day >= r.RecurringStart || day <= r.RecurringEnd
+	}
+	return true
+}
+
+// Describe explains in words what the rule grants and under which conditions
+func (r *DiscountRule) Describe() string {
+	var description string
+	switch {
+	case r.Scope == DiscountScopeLine && r.Kind == DiscountKindFixed:
+		description = fmt.Sprintf("%s off each unit of a matching line", r.Amount)
+	case r.Scope == DiscountScopeLine:
+		description = fmt.Sprintf("%g%% off each matching line", r.Value)
+	case r.Kind == DiscountKindFixed:
+		description = fmt.Sprintf("%s off the order", r.Amount)
+	default:
+		description = fmt.Sprintf("%g%% off the order", r.Value)
+	}
+
+	var conditions []string
+	if r.MinQuantity > 0 {
+		if r.Scope == DiscountScopeLine {
+			conditions = append(conditions, fmt.Sprintf("line quantity of at least %d", r.MinQuantity))
+		} else {
+			conditions = append(conditions, fmt.Sprintf("at least %d units in total", r.MinQuantity))
+		}
+	}
+	if r.ItemID != nil {
+		if r.Scope == DiscountScopeLine {
+			conditions = append(conditions, fmt.Sprintf("item %d only", *r.ItemID))
+		} else {
+			conditions = append(conditions, fmt.Sprintf("order contains item %d", *r.ItemID))
+		}
+	}
+	if r.MinOrderCount > 0 {
+		conditions = append(conditions, fmt.Sprintf("at least %d previous orders", r.MinOrderCount))
+	}
+	if r.UserID != nil {
+		conditions = append(conditions, fmt.Sprintf("customer %d only", *r.UserID))
+	}
+	if r.RecurringStart != "" {
+		conditions = append(conditions, fmt.Sprintf("every year from %s to %s", r.RecurringStart, r.RecurringEnd))
+	}
+	if r.StartsAt != nil {
+		conditions = append(conditions, "from "+r.StartsAt.Format(time.RFC3339))
+	}
+	if r.EndsAt != nil {
+		conditions = append(conditions, "until "+r.EndsAt.Format(time.RFC3339))
+	}
+
+	if len(conditions) == 0 {
+		return r.Name + ": " + description
+	}
+	return r.Name + ": " + description + " (" + strings.Join(conditions, ", ") + ")"
+}
+
+// DefaultDiscountRules are the rules the OMS used to hard-code. They are seeded into an empty rule table.
+func DefaultDiscountRules() []DiscountRule {
+	return []DiscountRule{
+		{Name: "Seasonal discount", Kind: DiscountKindPercentage, Scope: DiscountScopeOrder, Value: 15, Priority: 30,
+			Stacking: DiscountStackingStackable, Active: true, RecurringStart: "12-03", RecurringEnd: "12-31"},
+		{Name: "Volume discount", Kind: DiscountKindPercentage, Scope: DiscountScopeLine, Value: 10, Priority: 20,
+			Stacking: DiscountStackingStackable, Active: true, MinQuantity: 10},
+		{Name: "Loyalty discount", Kind: DiscountKindPercentage, Scope: DiscountScopeOrder, Value: 5, Priority: 10,
+			Stacking: DiscountStackingStackable, Active: true, MinOrderCount: 5},
+	}
+}
+
+var (
+	discountKindToPb = map[DiscountKind]pb.DiscountKind{
+		DiscountKindPercentage: pb.DiscountKind_DISCOUNT_KIND_PERCENTAGE,
+		DiscountKindFixed:      pb.DiscountKind_DISCOUNT_KIND_FIXED,
+	}
+	discountScopeToPb = map[DiscountScope]pb.DiscountScope{
+		DiscountScopeOrder: pb.DiscountScope_DISCOUNT_SCOPE_ORDER,
+		DiscountScopeLine:  pb.DiscountScope_DISCOUNT_SCOPE_LINE,
+	}
+	discountStackingToPb = map[DiscountStacking]pb.DiscountStacking{
+		DiscountStackingStackable: pb.DiscountStacking_DISCOUNT_STACKING_STACKABLE,
+		DiscountStackingExclusive: pb.DiscountStacking_DISCOUNT_STACKING_EXCLUSIVE,
+	}
+)
+
+// ToPb converts the DiscountRule model to the protobuf DiscountRule
+func (r *DiscountRule) ToPb() *pb.DiscountRule {
+	conditions := &pb.DiscountConditions{
+		RecurringStart: r.RecurringStart,
+		RecurringEnd:   r.RecurringEnd,
+		MinQuantity:    r.MinQuantity,
+		MinOrderCount:  r.MinOrderCount,
+	}
+	if r.StartsAt != nil {
+		conditions.StartsAt = r.StartsAt.Format(time.RFC3339)
+	}
+	if r.EndsAt != nil {
+		conditions.EndsAt = r.EndsAt.Format(time.RFC3339)
+	}
+	if r.UserID != nil {
+		conditions.UserId = *r.UserID
+	}
+	if r.ItemID != nil {
+		conditions.ItemId = *r.ItemID
+	}
+
+	rule := &pb.DiscountRule{
+		Id:         r.ID,
+		Name:       r.Name,
+		Kind:       discountKindToPb[r.Kind],
+		Scope:      discountScopeToPb[r.Scope],
+		Value:      r.Value,
+		Priority:   r.Priority,
+		Stacking:   discountStackingToPb[r.Stacking],
+		Active:     r.Active,
+		Conditions: conditions,
+		CreatedAt:  r.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  r.UpdatedAt.Format(time.RFC3339),
+	}
+	if r.Kind == DiscountKindFixed {
+		rule.Value = r.Amount.Major()
+		rule.Amount = r.Amount.ToPb()
+	}
+	return rule
+}
+
+// DiscountRuleFromPb validates a protobuf DiscountRule and converts it to the model. The id and
+// timestamps are not copied.
+func DiscountRuleFromPb(rule *pb.DiscountRule) (DiscountRule, error) {
+	if rule == nil || rule.GetName() == "" {
+		return DiscountRule{}, fmt.Errorf("rule name is required")
+	}
+
+	result := DiscountRule{
+		Name:     rule.GetName(),
+		Value:    rule.GetValue(),
+		Priority: rule.GetPriority(),
+		Active:   rule.GetActive(),
+		Stacking: DiscountStackingStackable,
+	}
+
+	for kind, value := range discountKindToPb {
+		if value == rule.GetKind() {
+			result.Kind = kind
+		}
+	}
+	if result.Kind == "" {
+		return DiscountRule{}, fmt.Errorf("rule kind is required")
+	}
+	for scope, value := range discountScopeToPb {
+		if value == rule.GetScope() {
+			result.Scope = scope
+		}
+	}
+	if result.Scope == "" {
+		return DiscountRule{}, fmt.Errorf("rule scope is required")
+	}
+	for stacking, value := range discountStackingToPb {
+		if value == rule.GetStacking() {
+			result.Stacking = stacking
+		}
+	}
+
+	amount, err := discountAmountFromPb(result.Kind, rule.GetValue(), rule.GetAmount())
+	if err != nil {
+		return DiscountRule{}, fmt.Errorf("rule %v", err)
+	}
+	if result.Kind == DiscountKindFixed {
+		result.Value = 0
+		result.Amount = amount
+	}
+
+	conditions := rule.GetConditions()
+	if startsAt := conditions.GetStartsAt(); startsAt != "" {
+		parsed, err := time.Parse(time.RFC3339, startsAt)
+		if err != nil {
+			return DiscountRule{}, fmt.Errorf("starts_at must be an RFC 3339 timestamp")
+		}
+		result.StartsAt = &parsed
+	}
+	if endsAt := conditions.GetEndsAt(); endsAt != "" {
+		parsed, err := time.Parse(time.RFC3339, endsAt)
+		if err != nil {
+			return DiscountRule{}, fmt.Errorf("ends_at must be an RFC 3339 timestamp")
+		}
+		result.EndsAt = &parsed
+	}
+
+	result.RecurringStart = conditions.GetRecurringStart()
+	result.RecurringEnd = conditions.GetRecurringEnd()
+	if (result.RecurringStart == "") != (result.RecurringEnd == "") {
+		return DiscountRule{}, fmt.Errorf("recurring_start and recurring_end must be set together")
+	}
+	for _, day := range []string{result.RecurringStart, result.RecurringEnd} {
+		if _, err := time.Parse("01-02", day); day != "" && err != nil {
+			return DiscountRule{}, fmt.Errorf("recurring window days must use the MM-DD format")
+		}
+	}
+
+	result.MinQuantity = conditions.GetMinQuantity()
+	result.MinOrderCount = conditions.GetMinOrderCount()
+	if userID := conditions.GetUserId(); userID != 0 {
+		result.UserID = &userID
+	}
+	if itemID := conditions.GetItemId(); itemID != 0 {
+		result.ItemID = &itemID
+	}
+
+	return result, nil
+}
+
+// discountAmountFromPb validates the value of a percentage discount, or resolves the amount of a fixed one.
+// Fixed discounts sent without an amount take value as an amount in the default currency.
+func discountAmountFromPb(kind DiscountKind, value float64, amount *pb.Money) (Money, error) {
+	if kind == DiscountKindPercentage {
+		if value <= 0 || value > 100 {
+			return Money{}, fmt.Errorf("value must be a percentage between 0 and 100")
+		}
+		return Money{}, nil
+	}
+
+	if amount == nil {
+		if value <= 0 {
+			return Money{}, fmt.Errorf("amount must be positive")
+		}
+		return MoneyFromMajor(value, DefaultCurrency), nil
+	}
+	result, err := MoneyFromPb(amount)
+	if err != nil {
+		return Money{}, fmt.Errorf("amount: %v", err)
+	}
+	if result.MinorUnits <= 0 {
+		return Money{}, fmt.Errorf("amount must be positive")
+	}
+	return result, nil
+}
+
+// AppliedDiscount is one discount granted to an order by a rule or a coupon
+type AppliedDiscount struct {
+	RuleID   int32         `json:"rule_id"`
+	CouponID int32         `json:"coupon_id"`
+	Name     string        `json:"name"`
+	Kind     DiscountKind  `json:"kind"`
+	Scope    DiscountScope `json:"scope"`
+	ItemID   int32         `json:"item_id"`  // The discounted line's item for line rules
+	ItemIDs  []int32       `json:"item_ids"` // Items an order discount was computed on; empty for the whole order
+	Amount   int64         `json:"amount"`   // Minor units of the order currency
+	Reason   string        `json:"reason"`   // What the rule or coupon grants and under which conditions
+}
+
+// Discounts is the result of evaluating the discount rules for an order
 type Discounts struct {
-	SeasonalDiscount    float64 `json:"seasonal_discount"`
-	VolumeBasedDiscount float64 `json:"volume_based_discount"`
-	LoyaltyDiscount     float64 `json:"loyalty_discount"`
-	TotalDiscountAmount float64 `json:"total_discount_amount"`
+	Currency            string            `json:"currency"` // Currency of the order the amounts are in
+	Applied             []AppliedDiscount `json:"applied"`
+	TotalDiscountAmount int64             `json:"total_discount_amount"` // Minor units
 }
 
 type DiscountRequest struct {
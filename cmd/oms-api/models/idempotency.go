@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// IdempotencyKey stores the outcome of a mutating request so a retry with the same key can replay it
+type IdempotencyKey struct {
+	Principal   string     `json:"principal" gorm:"primaryKey"` // Caller the key belongs to, e.g. "user:7"; empty for unauthenticated calls
+	Method      string     `json:"method" gorm:"primaryKey"`    // Full gRPC method the key was used with
+	Key         string     `json:"key" gorm:"primaryKey"`
+	Fingerprint string     `json:"fingerprint"`  // Hash of the method and request payload
+	Response    []byte     `json:"response"`     // Encoded response; empty while the request is still running
+	LockedUntil *time.Time `json:"locked_until"` // End of the running request's lease; a retry may take the key over after it
+	CreatedAt   time.Time  `json:"created_at"`
+	ExpiresAt   time.Time  `json:"expires_at" gorm:"index"`
+}
@@ -9,32 +9,65 @@ import (
 
 // Item represents an item in the OMS system
 type Item struct {
-	ID          int32          `json:"id"`
-	Name        string         `json:"name"`
-	Description string         `json:"description"`
-	Price       int32          `json:"price"`
-	CreatedAt   time.Time      `json:"created_at"` // Change to time.Time
-	UpdatedAt   time.Time      `json:"updated_at"` // Change to time.Time
-	DeletedAt   gorm.DeletedAt `json:"deleted_at"`
+	ID            int32          `json:"id"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	Price         Money          `json:"price" gorm:"embedded;embeddedPrefix:price_"` // Base price
+	Prices        []ItemPrice    `json:"prices" gorm:"foreignKey:ItemID"`             // Explicit prices in other currencies
+	TaxCategory   string         `json:"tax_category"`                                // Empty for the standard tax rate
+	StockOnHand   int32          `json:"stock_on_hand"`                               // Units physically in stock, including reserved ones
+	StockReserved int32          `json:"stock_reserved"`                              // Units held for orders that are not confirmed yet
+	CreatedAt     time.Time      `json:"created_at"`                                  // Change to time.Time
+	UpdatedAt     time.Time      `json:"updated_at"`                                  // Change to time.Time
+	DeletedAt     gorm.DeletedAt `json:"deleted_at"`
 }
 
+// StockMovement records a change to the on-hand or reserved stock of an item
+type StockMovement struct {
+	ID            int32     `json:"id"`
+	ItemID        int32     `json:"item_id" gorm:"index"`
+	OrderID       *int32    `json:"order_id"`
+	OnHandDelta   int32     `json:"on_hand_delta"`
+	ReservedDelta int32     `json:"reserved_delta"`
+	Reason        string    `json:"reason"`
+	CreatedAt     time.Time `json:"created_at"`
+}
 
-
-
-
-
+// StockAvailable returns the units that can still be sold
+func (item *Item) StockAvailable() int32 {
+	return item.StockOnHand - item.StockReserved
+}
 
 // TableName sets the schema and table name for the Item model
 // func (ItemNew) TableName() string {
 //     return "oms.item_new" // Specify the full table name with the schema
 // }
 
-// ToPb converts the Item model to the protobuf ItemResponse
+// ToPb converts the Item model and its loaded prices to the protobuf ItemResponse
 func (item *Item) ToPb() *pb.ItemResponse {
-	return &pb.ItemResponse{
-		Id:          item.ID,
-		Name:        item.Name,
-		Description: item.Description,
-		Price:       item.Price,
+	response := &pb.ItemResponse{
+		Id:             item.ID,
+		Name:           item.Name,
+		Description:    item.Description,
+		Price:          int32(item.Price.Major()),
+		StockOnHand:    item.StockOnHand,
+		StockReserved:  item.StockReserved,
+		StockAvailable: item.StockAvailable(),
+		UnitPrice:      item.Price.ToPb(),
+		TaxCategory:    item.TaxCategory,
+	}
+	for i := range item.Prices {
+		response.Prices = append(response.Prices, item.Prices[i].Money().ToPb())
+	}
+	return response
+}
+
+// ToStockPb converts the stock fields of the Item model to the protobuf StockLevel
+func (item *Item) ToStockPb() *pb.StockLevel {
+	return &pb.StockLevel{
+		ItemId:    item.ID,
+		OnHand:    item.StockOnHand,
+		Reserved:  item.StockReserved,
+		Available: item.StockAvailable(),
 	}
 }
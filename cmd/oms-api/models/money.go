@@ -0,0 +1,124 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+)
+
+// DefaultCurrency is the currency of amounts that predate currency codes, and of amounts sent through the
+// deprecated numeric proto fields. It is set from configuration at startup.
+var DefaultCurrency = "USD"
+
+// currencyExponents lists the ISO 4217 currencies whose minor unit is not a hundredth of the major unit
+var currencyExponents = map[string]int{
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+}
+
+// CurrencyExponent returns the number of decimal places of the minor unit of currency, e.g. 2 for USD
+func CurrencyExponent(currency string) int {
+	if exponent, ok := currencyExponents[currency]; ok {
+		return exponent
+	}
+	return 2
+}
+
+// minorUnitsPerMajor returns how many minor units make up one major unit of currency
+func minorUnitsPerMajor(currency string) int64 {
+	factor := int64(1)
+	for i := 0; i < CurrencyExponent(currency); i++ {
+		factor *= 10
+	}
+	return factor
+}
+
+// NormalizeCurrency validates an ISO 4217 code and returns it upper case
+func NormalizeCurrency(currency string) (string, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if len(currency) != 3 {
+		return "", fmt.Errorf("currency must be a three-letter ISO 4217 code")
+	}
+	for _, r := range currency {
+		if r < 'A' || r > 'Z' {
+			return "", fmt.Errorf("currency must be a three-letter ISO 4217 code")
+		}
+	}
+	return currency, nil
+}
+
+// Money is an amount in the minor units of a currency, e.g. cents for USD. Embed it in models with a
+// column prefix, e.g. `gorm:"embedded;embeddedPrefix:price_"`.
+type Money struct {
+	MinorUnits int64  `json:"minor_units"`
+	Currency   string `json:"currency" gorm:"size:3"`
+}
+
+// NewMoney creates an amount of minorUnits in currency
+func NewMoney(minorUnits int64, currency string) Money {
+	return Money{MinorUnits: minorUnits, Currency: currency}
+}
+
+// MoneyFromMajor converts an amount in major units, e.g. 12.5 dollars, rounding half away from zero to
+// the minor unit
+func MoneyFromMajor(amount float64, currency string) Money {
+	return NewMoney(int64(math.Round(amount*float64(minorUnitsPerMajor(currency)))), currency)
+}
+
+// Major returns the amount in major units. It is only meant for display and the deprecated numeric proto
+// fields; calculations stay in minor units.
+func (m Money) Major() float64 {
+	return float64(m.MinorUnits) / float64(minorUnitsPerMajor(m.Currency))
+}
+
+// String formats the amount with its currency, e.g. "12.50 USD"
+func (m Money) String() string {
+	return fmt.Sprintf("%.*f %s", CurrencyExponent(m.Currency), m.Major(), m.Currency)
+}
+
+// PercentOf returns percent of an amount of minor units, rounded half away from zero to the minor unit.
+// Discounts use it for every percentage they take, so each discount line is rounded exactly once.
+func PercentOf(minorUnits int64, percent float64) int64 {
+	return int64(math.Round(float64(minorUnits) * percent / 100))
+}
+
+// ToPb converts the amount to the protobuf Money, which splits it into whole units and nanos
+func (m Money) ToPb() *pb.Money {
+	factor := minorUnitsPerMajor(m.Currency)
+	return &pb.Money{
+		CurrencyCode: m.Currency,
+		Units:        m.MinorUnits / factor,
+		Nanos:        int32((m.MinorUnits % factor) * (1_000_000_000 / factor)),
+	}
+}
+
+// MoneyFromPb validates a protobuf Money and converts it to minor units. Amounts finer than the minor
+// unit of the currency are rejected rather than rounded.
+func MoneyFromPb(money *pb.Money) (Money, error) {
+	if money == nil {
+		return Money{}, fmt.Errorf("amount is required")
+	}
+	currency, err := NormalizeCurrency(money.GetCurrencyCode())
+	if err != nil {
+		return Money{}, err
+	}
+
+	units, nanos := money.GetUnits(), int64(money.GetNanos())
+	if nanos <= -1_000_000_000 || nanos >= 1_000_000_000 || (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return Money{}, fmt.Errorf("nanos must be within one unit and have the same sign as units")
+	}
+
+	factor := minorUnitsPerMajor(currency)
+	nanosPerMinor := 1_000_000_000 / factor
+	if nanos%nanosPerMinor != 0 {
+		return Money{}, fmt.Errorf("%s amounts have at most %d decimal places", currency, CurrencyExponent(currency))
+	}
+	if units > math.MaxInt64/factor || units < math.MinInt64/factor {
+		return Money{}, fmt.Errorf("amount is too large")
+	}
+
+	return NewMoney(units*factor+nanos/nanosPerMinor, currency), nil
+}
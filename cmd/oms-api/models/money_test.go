@@ -0,0 +1,113 @@
+package models
+
+import (
+	"math"
+	"testing"
+
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+)
+
+func TestPercentOf(t *testing.T) {
+	for _, test := range []struct {
+		minorUnits int64
+		percent    float64
+		want       int64
+	}{
+		{1000, 15, 150},
+		{0, 10, 0},
+		{1000, 0, 0},
+		{999, 1.5, 15},   // 14.985
+		{8330, 1.5, 125}, // 124.95
+		{1, 50, 1},       // 0.5 rounds away from zero
+		{-1, 50, -1},     // and so does -0.5
+		{-999, 1.5, -15},
+		{3, 33.3, 1}, // 0.999
+		{1000, 100, 1000},
+	} {
+		if got := PercentOf(test.minorUnits, test.percent); got != test.want {
+			t.Errorf("PercentOf(%d, %v) = %d, want %d", test.minorUnits, test.percent, got, test.want)
+		}
+	}
+}
+
+func TestMoneyFromMajor(t *testing.T) {
+	for _, test := range []struct {
+		amount   float64
+		currency string
+		want     Money
+	}{
+		{12.5, "USD", NewMoney(1250, "USD")},
+		{0.125, "USD", NewMoney(13, "USD")},
+		{-0.125, "USD", NewMoney(-13, "USD")},
+		{1500, "JPY", NewMoney(1500, "JPY")},
+		{0.5, "JPY", NewMoney(1, "JPY")},
+		{1.5, "KWD", NewMoney(1500, "KWD")},
+	} {
+		if got := MoneyFromMajor(test.amount, test.currency); got != test.want {
+			t.Errorf("MoneyFromMajor(%v, %s) = %v, want %v", test.amount, test.currency, got, test.want)
+		}
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	for _, test := range []struct {
+		money Money
+		want  string
+	}{
+		{NewMoney(1250, "USD"), "12.50 USD"},
+		{NewMoney(-5, "USD"), "-0.05 USD"},
+		{NewMoney(1500, "JPY"), "1500 JPY"},
+		{NewMoney(1234, "KWD"), "1.234 KWD"},
+	} {
+		if got := test.money.String(); got != test.want {
+			t.Errorf("%#v.String() = %q, want %q", test.money, got, test.want)
+		}
+	}
+}
+
+func TestMoneyToPb(t *testing.T) {
+	for _, test := range []struct {
+		money Money
+		units int64
+		nanos int32
+	}{
+		{NewMoney(1250, "USD"), 12, 500_000_000},
+		{NewMoney(-1250, "USD"), -12, -500_000_000},
+		{NewMoney(1500, "JPY"), 1500, 0},
+		{NewMoney(1234, "KWD"), 1, 234_000_000},
+	} {
+		got := test.money.ToPb()
+		if got.GetCurrencyCode() != test.money.Currency || got.GetUnits() != test.units || got.GetNanos() != test.nanos {
+			t.Errorf("%s.ToPb() = %v, want %d units and %d nanos", test.money, got, test.units, test.nanos)
+		}
+		if back, err := MoneyFromPb(got); err != nil || back != test.money {
+			t.Errorf("MoneyFromPb(%v) = %v, %v, want %v", got, back, err, test.money)
+		}
+	}
+}
+
+func TestMoneyFromPb(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		money   *pb.Money
+		want    Money
+		wantErr bool
+	}{
+		{"dollars and cents", &pb.Money{CurrencyCode: "usd", Units: 12, Nanos: 500_000_000}, NewMoney(1250, "USD"), false},
+		{"negative", &pb.Money{CurrencyCode: "USD", Units: -12, Nanos: -500_000_000}, NewMoney(-1250, "USD"), false},
+		{"fils", &pb.Money{CurrencyCode: "KWD", Nanos: 1_000_000}, NewMoney(1, "KWD"), false},
+		{"yen", &pb.Money{CurrencyCode: "JPY", Units: 1500}, NewMoney(1500, "JPY"), false},
+		{"missing", nil, Money{}, true},
+		{"bad currency", &pb.Money{CurrencyCode: "US", Units: 1}, Money{}, true},
+		{"signs differ", &pb.Money{CurrencyCode: "USD", Units: 1, Nanos: -1_000_000}, Money{}, true},
+		{"nanos over a unit", &pb.Money{CurrencyCode: "USD", Nanos: 1_000_000_000}, Money{}, true},
+		{"finer than a cent", &pb.Money{CurrencyCode: "USD", Nanos: 5_000_000}, Money{}, true},
+		{"fraction of a yen", &pb.Money{CurrencyCode: "JPY", Units: 1, Nanos: 500_000_000}, Money{}, true},
+		{"too large", &pb.Money{CurrencyCode: "USD", Units: math.MaxInt64 / 10}, Money{}, true},
+	} {
+		got, err := MoneyFromPb(test.money)
+		if (err != nil) != test.wantErr || got != test.want {
+			t.Errorf("%s: got %v, %v, want %v with error %v", test.name, got, err, test.want, test.wantErr)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package models
+
+import (
+	"time"
+
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+)
+
+// OrderStatus is the lifecycle state of an order as stored in the orders table
+type OrderStatus string
+
+const (
+	OrderStatusDraft     OrderStatus = "Draft"
+	OrderStatusPending   OrderStatus = "Pending"
+	OrderStatusConfirmed OrderStatus = "Confirmed"
+	OrderStatusPacked    OrderStatus = "Packed"
+	OrderStatusShipped   OrderStatus = "Shipped"
+	OrderStatusDelivered OrderStatus = "Delivered"
+	OrderStatusCancelled OrderStatus = "Cancelled"
+	OrderStatusReturned  OrderStatus = "Returned"
+
+	OrderStatusPartiallyShipped OrderStatus = "PartiallyShipped"
+)
+
+// orderTransitions is the single source of truth for which status an order may move to next.
+// Statuses missing from the map (Cancelled, Returned) are terminal.
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusDraft:     {OrderStatusPending, OrderStatusCancelled},
+	OrderStatusPending:   {OrderStatusConfirmed, OrderStatusCancelled, OrderStatusDraft},
+	OrderStatusConfirmed: {OrderStatusPacked, OrderStatusPartiallyShipped, OrderStatusShipped, OrderStatusCancelled},
+	OrderStatusPacked:    {OrderStatusPartiallyShipped, OrderStatusShipped, OrderStatusCancelled},
+	OrderStatusShipped:   {OrderStatusDelivered},
+	OrderStatusDelivered: {OrderStatusReturned},
+
+	OrderStatusPartiallyShipped: {OrderStatusShipped},
+}
+
+// CanTransitionTo reports whether an order in status s may move to target
+func (s OrderStatus) CanTransitionTo(target OrderStatus) bool {
+	for _, next := range orderTransitions[s] {
+		if next == target {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEditable reports whether the line items of an order in status s may still change
+func (s OrderStatus) IsEditable() bool {
+	return s == OrderStatusDraft || s == OrderStatusPending
+}
+
+// IsRecorded reports whether orders reach status s by recording shipments, deliveries or refunded returns
+// rather than by being moved there directly
+func (s OrderStatus) IsRecorded() bool {
+	switch s {
+	case OrderStatusPartiallyShipped, OrderStatusShipped, OrderStatusDelivered, OrderStatusReturned:
+		return true
+	}
+	return false
+}
+
+// IsShippable reports whether shipments may be recorded for an order in status s
+func (s OrderStatus) IsShippable() bool {
+	return s == OrderStatusConfirmed || s == OrderStatusPacked || s == OrderStatusPartiallyShipped
+}
+
+var orderStatusToPb = map[OrderStatus]pb.OrderStatus{
+	OrderStatusDraft:     pb.OrderStatus_ORDER_STATUS_DRAFT,
+	OrderStatusPending:   pb.OrderStatus_ORDER_STATUS_PENDING,
+	OrderStatusConfirmed: pb.OrderStatus_ORDER_STATUS_CONFIRMED,
+	OrderStatusPacked:    pb.OrderStatus_ORDER_STATUS_PACKED,
+	OrderStatusShipped:   pb.OrderStatus_ORDER_STATUS_SHIPPED,
+	OrderStatusDelivered: pb.OrderStatus_ORDER_STATUS_DELIVERED,
+	OrderStatusCancelled: pb.OrderStatus_ORDER_STATUS_CANCELLED,
+	OrderStatusReturned:  pb.OrderStatus_ORDER_STATUS_RETURNED,
+
+	OrderStatusPartiallyShipped: pb.OrderStatus_ORDER_STATUS_PARTIALLY_SHIPPED,
+}
+
+// ToPb converts the status to its protobuf enum value
+func (s OrderStatus) ToPb() pb.OrderStatus {
+	return orderStatusToPb[s]
+}
+
+// OrderStatusFromPb converts a protobuf enum value to an OrderStatus.
+// It returns false for ORDER_STATUS_UNSPECIFIED and unknown values.
+func OrderStatusFromPb(value pb.OrderStatus) (OrderStatus, bool) {
+	for s, v := range orderStatusToPb {
+		if v == value {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// OrderStatusHistory records every status change applied to an order
+type OrderStatusHistory struct {
+	ID         int32       `json:"id"`
+	OrderID    int32       `json:"order_id" gorm:"index"`
+	FromStatus OrderStatus `json:"from_status"`
+	ToStatus   OrderStatus `json:"to_status"`
+	Reason     string      `json:"reason"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
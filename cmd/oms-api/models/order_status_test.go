@@ -0,0 +1,49 @@
+package models
+
+import "testing"
+
+func TestOrderStatusCanTransitionTo(t *testing.T) {
+	statuses := []OrderStatus{
+		OrderStatusDraft, OrderStatusPending, OrderStatusConfirmed, OrderStatusPacked, OrderStatusPartiallyShipped,
+		OrderStatusShipped, OrderStatusDelivered, OrderStatusCancelled, OrderStatusReturned,
+	}
+	allowed := map[OrderStatus][]OrderStatus{
+		OrderStatusDraft:            {OrderStatusPending, OrderStatusCancelled},
+		OrderStatusPending:          {OrderStatusConfirmed, OrderStatusCancelled, OrderStatusDraft},
+		OrderStatusConfirmed:        {OrderStatusPacked, OrderStatusPartiallyShipped, OrderStatusShipped, OrderStatusCancelled},
+		OrderStatusPacked:           {OrderStatusPartiallyShipped, OrderStatusShipped, OrderStatusCancelled},
+		OrderStatusPartiallyShipped: {OrderStatusShipped},
+		OrderStatusShipped:          {OrderStatusDelivered},
+		OrderStatusDelivered:        {OrderStatusReturned},
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			want := false
+			for _, next := range allowed[from] {
+				want = want || next == to
+			}
+			if got := from.CanTransitionTo(to); got != want {
+				t.Errorf("%s -> %s allowed = %v, want %v", from, to, got, want)
+			}
+		}
+	}
+}
+
+func TestOrderStatusIsRecorded(t *testing.T) {
+	for status, want := range map[OrderStatus]bool{
+		OrderStatusDraft:            false,
+		OrderStatusPending:          false,
+		OrderStatusConfirmed:        false,
+		OrderStatusPacked:           false,
+		OrderStatusCancelled:        false,
+		OrderStatusPartiallyShipped: true,
+		OrderStatusShipped:          true,
+		OrderStatusDelivered:        true,
+		OrderStatusReturned:         true,
+	} {
+		if got := status.IsRecorded(); got != want {
+			t.Errorf("%s.IsRecorded() = %v, want %v", status, got, want)
+		}
+	}
+}
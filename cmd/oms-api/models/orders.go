@@ -3,32 +3,152 @@ package models
 import (
 	"time"
 
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
 	"gorm.io/gorm"
 )
 
 // Order represents an order in the OMS system
 type Order struct {
-	ID         int32            `json:"id"`
-	UserID     int32            `json:"user_id"`
-	TotalPrice float64        `json:"total_price"`
-	Status     string         `json:"status"`
-	FinalPrice float64        `json:"final_price"` // Total price after applying discounts
-	Items      []OrderItem    `json:"items"`       // List of items in the order
-	CreatedAt  time.Time      `json:"created_at"`
-	UpdatedAt  time.Time      `json:"updated_at"`
-	DeletedAt  gorm.DeletedAt `json:"deleted_at"`
+	ID               int32               `json:"id"`
+	UserID           int32               `json:"user_id"`
+	TotalPrice       Money               `json:"total_price" gorm:"embedded;embeddedPrefix:total_price_"` // Subtotal: the sum of the line prices
+	Status           OrderStatus         `json:"status"`
+	FinalPrice       Money               `json:"final_price" gorm:"embedded;embeddedPrefix:final_price_"` // Total after discounts and tax
+	TaxTotal         Money               `json:"tax_total" gorm:"embedded;embeddedPrefix:tax_total_"`
+	TaxRegion        string              `json:"tax_region"`         // Shipping region the order is taxed in
+	PricesIncludeTax bool                `json:"prices_include_tax"` // Whether the line prices include tax, as configured when the order was created
+	Items            []OrderItem         `json:"items"`              // List of items in the order
+	CouponID         *int32              `json:"coupon_id"`          // Coupon redeemed on the order, if any
+	CouponCode       string              `json:"coupon_code"`
+	Adjustments      []OrderAdjustment   `json:"adjustments"`    // Discounts making up the difference between TotalPrice and FinalPrice
+	ExchangeRates    []OrderExchangeRate `json:"exchange_rates"` // Rates the order was priced with
+	Addresses        []OrderAddress      `json:"addresses"`      // Shipping and billing address as they were when the order was placed
+	Shipments        []Shipment          `json:"shipments"`
+	CreatedAt        time.Time           `json:"created_at"`
+	UpdatedAt        time.Time           `json:"updated_at"`
+	DeletedAt        gorm.DeletedAt      `json:"deleted_at"`
+}
+
+// DiscountTotal returns the sum of the loaded adjustments
+func (o *Order) DiscountTotal() Money {
+	total := NewMoney(0, o.TotalPrice.Currency)
+	for _, adjustment := range o.Adjustments {
+		total.MinorUnits += adjustment.Amount.MinorUnits
+	}
+	return total
+}
+
+// Address returns the order's address of kind, or nil when it has none
+func (o *Order) Address(kind OrderAddressKind) *OrderAddress {
+	for i := range o.Addresses {
+		if o.Addresses[i].Kind == kind {
+			return &o.Addresses[i]
+		}
+	}
+	return nil
 }
 
 // OrderItem represents an item in an order
 type OrderItem struct {
-	ID        int32            `json:"id"`
-	OrderID   int32            `json:"order_id"`
-	ItemID    int32            `json:"item_id"`
-	Quantity  int32           `json:"quantity"`
-	Price     float64        `json:"price"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `json:"deleted_at"`
+	ID          int32          `json:"id"`
+	OrderID     int32          `json:"order_id"`
+	ItemID      int32          `json:"item_id"`
+	Quantity    int32          `json:"quantity"`
+	Price       Money          `json:"price" gorm:"embedded;embeddedPrefix:price_"` // Unit price
+	ItemName    string         `json:"item_name,omitempty" gorm:"->;-:migration"`   // Filled only by reads that join the items table
+	TaxCategory string         `json:"tax_category"`
+	Discount    Money          `json:"discount" gorm:"embedded;embeddedPrefix:discount_"` // Share of the order's discounts taken off the line
+	TaxRate     float64        `json:"tax_rate"`                                          // Percentage the line was taxed at
+	Tax         Money          `json:"tax" gorm:"embedded;embeddedPrefix:tax_"`           // Tax on the line after its discount
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `json:"deleted_at"`
+}
+
+// Subtotal returns the price of the line before discounts, in minor units
+func (item *OrderItem) Subtotal() int64 {
+	return item.Price.MinorUnits * int64(item.Quantity)
+}
+
+// ToPb converts the OrderItem model to the protobuf OrderItemForResponse
+func (item *OrderItem) ToPb() *pb.OrderItemForResponse {
+	return &pb.OrderItemForResponse{
+		ItemId:        item.ItemID,
+		Quantity:      item.Quantity,
+		Price:         item.Price.Major(),
+		ItemName:      item.ItemName,
+		PriceMoney:    item.Price.ToPb(),
+		TaxCategory:   item.TaxCategory,
+		TaxRate:       item.TaxRate,
+		DiscountMoney: item.Discount.ToPb(),
+		TaxMoney:      item.Tax.ToPb(),
+	}
+}
+
+// OrderAdjustment is a discount applied to an order, persisted so every price can be explained later.
+// Adjustments are replaced whenever the order is priced again.
+type OrderAdjustment struct {
+	ID        int32         `json:"id"`
+	OrderID   int32         `json:"order_id" gorm:"index"`
+	RuleID    *int32        `json:"rule_id"`   // Set for discount rules
+	CouponID  *int32        `json:"coupon_id"` // Set for coupons
+	Name      string        `json:"name"`
+	Kind      DiscountKind  `json:"kind"`
+	Scope     DiscountScope `json:"scope"`
+	ItemID    *int32        `json:"item_id"` // The discounted line's item for line adjustments
+	Amount    Money         `json:"amount" gorm:"embedded;embeddedPrefix:amount_"`
+	Reason    string        `json:"reason"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// AdjustmentsFromDiscounts converts the discounts computed for an order into adjustment rows
+func AdjustmentsFromDiscounts(discounts Discounts) []OrderAdjustment {
+	adjustments := make([]OrderAdjustment, 0, len(discounts.Applied))
+	for _, discount := range discounts.Applied {
+		adjustment := OrderAdjustment{
+			Name:   discount.Name,
+			Kind:   discount.Kind,
+			Scope:  discount.Scope,
+			Amount: NewMoney(discount.Amount, discounts.Currency),
+			Reason: discount.Reason,
+		}
+		if discount.RuleID != 0 {
+			ruleID := discount.RuleID
+			adjustment.RuleID = &ruleID
+		}
+		if discount.CouponID != 0 {
+			couponID := discount.CouponID
+			adjustment.CouponID = &couponID
+		}
+		if discount.ItemID != 0 {
+			itemID := discount.ItemID
+			adjustment.ItemID = &itemID
+		}
+		adjustments = append(adjustments, adjustment)
+	}
+	return adjustments
+}
+
+// ToPb converts the OrderAdjustment model to the protobuf OrderAdjustment
+func (a *OrderAdjustment) ToPb() *pb.OrderAdjustment {
+	adjustment := &pb.OrderAdjustment{
+		Name:        a.Name,
+		Kind:        discountKindToPb[a.Kind],
+		Scope:       discountScopeToPb[a.Scope],
+		Amount:      a.Amount.Major(),
+		Reason:      a.Reason,
+		AmountMoney: a.Amount.ToPb(),
+	}
+	if a.RuleID != nil {
+		adjustment.RuleId = *a.RuleID
+	}
+	if a.CouponID != nil {
+		adjustment.CouponId = *a.CouponID
+	}
+	if a.ItemID != nil {
+		adjustment.ItemId = *a.ItemID
+	}
+	return adjustment
 }
 
 // type OrderResposnse struct {
@@ -68,3 +188,43 @@ type OrderItem struct {
 // 	Quantity int32     `json:"quantity"`
 // 	Price    float64 `json:"price"`
 // }
+
+// ToPb converts the Order model and its loaded items, adjustments, exchange rates, addresses and shipments to the protobuf OrderResponse1
+func (o *Order) ToPb() *pb.OrderResponse1 {
+	response := &pb.OrderResponse1{
+		Id:               o.ID,
+		UserId:           o.UserID,
+		TotalPrice:       o.TotalPrice.Major(),
+		Status:           string(o.Status),
+		FinalPrice:       o.FinalPrice.Major(),
+		OrderStatus:      o.Status.ToPb(),
+		CouponCode:       o.CouponCode,
+		SubtotalMoney:    o.TotalPrice.ToPb(),
+		TaxTotalMoney:    o.TaxTotal.ToPb(),
+		TotalMoney:       o.FinalPrice.ToPb(),
+		TaxRegion:        o.TaxRegion,
+		PricesIncludeTax: o.PricesIncludeTax,
+	}
+	for i := range o.Items {
+		response.Items = append(response.Items, o.Items[i].ToPb())
+	}
+	for i := range o.Adjustments {
+		response.Adjustments = append(response.Adjustments, o.Adjustments[i].ToPb())
+	}
+	discountTotal := o.DiscountTotal()
+	response.DiscountTotal = discountTotal.Major()
+	response.DiscountTotalMoney = discountTotal.ToPb()
+	for i := range o.ExchangeRates {
+		response.ExchangeRates = append(response.ExchangeRates, o.ExchangeRates[i].ToPb())
+	}
+	if address := o.Address(OrderAddressShipping); address != nil {
+		response.ShippingAddress = address.ToPb()
+	}
+	if address := o.Address(OrderAddressBilling); address != nil {
+		response.BillingAddress = address.ToPb()
+	}
+	for i := range o.Shipments {
+		response.Shipments = append(response.Shipments, o.Shipments[i].ToPb())
+	}
+	return response
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/protobuf/proto"
+)
+
+// EventType names a kind of domain event
+type EventType string
+
+const (
+	EventOrderCreated       EventType = "order.created"
+	EventOrderUpdated       EventType = "order.updated"
+	EventOrderConfirmed     EventType = "order.confirmed"
+	EventOrderCancelled     EventType = "order.cancelled"
+	EventOrderStatusChanged EventType = "order.status_changed" // Any other status change
+	EventItemCreated        EventType = "item.created"
+	EventItemUpdated        EventType = "item.updated"
+	EventItemDeleted        EventType = "item.deleted"
+	EventUserCreated        EventType = "user.created"
+	EventUserUpdated        EventType = "user.updated"
+	EventUserDeleted        EventType = "user.deleted"
+)
+
+// EventTypes lists every event type
+var EventTypes = []EventType{
+	EventOrderCreated, EventOrderUpdated, EventOrderConfirmed, EventOrderCancelled, EventOrderStatusChanged,
+	EventItemCreated, EventItemUpdated, EventItemDeleted,
+	EventUserCreated, EventUserUpdated, EventUserDeleted,
+}
+
+// OrderStatusEventTypes lists the event types of an order changing status
+var OrderStatusEventTypes = []EventType{EventOrderConfirmed, EventOrderCancelled, EventOrderStatusChanged}
+
+// OrderStatusEvent returns the event type of an order moving to status
+func OrderStatusEvent(status OrderStatus) EventType {
+	switch status {
+	case OrderStatusConfirmed:
+		return EventOrderConfirmed
+	case OrderStatusCancelled:
+		return EventOrderCancelled
+	}
+	return EventOrderStatusChanged
+}
+
+// OutboxEvent is a domain event stored in the same transaction as the change it describes. Writers do not
+// wait for each other: an event is given its sequence only once every transaction older than its own has
+// ended, so readers following the sequence never pass over an event that commits late.
+type OutboxEvent struct {
+	ID            int64     `json:"id"`
+	Sequence      *int64    `json:"sequence" gorm:"uniqueIndex"`                                                   // Nil until sequenced
+	TransactionID int64     `json:"-" gorm:"not null;default:(pg_current_xact_id()::text::bigint);<-:false;index"` // Transaction that wrote the event
+	Type          EventType `json:"type" gorm:"index"`
+	AggregateType string    `json:"aggregate_type"`
+	AggregateID   int32     `json:"aggregate_id"`
+	Payload       []byte    `json:"payload"` // Protobuf encoding of the Event, without the columns above
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ToPb decodes the stored event into the protobuf Event
+func (e *OutboxEvent) ToPb() (*pb.Event, error) {
+	event := &pb.Event{}
+	if err := proto.Unmarshal(e.Payload, event); err != nil {
+		return nil, err
+	}
+	if e.Sequence != nil {
+		event.Sequence = *e.Sequence
+	}
+	event.Type = string(e.Type)
+	event.AggregateType = e.AggregateType
+	event.AggregateId = e.AggregateID
+	event.OccurredAt = e.CreatedAt.Format(time.RFC3339Nano)
+	return event, nil
+}
@@ -0,0 +1,137 @@
+package models
+
+import (
+	"time"
+
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+)
+
+// PaymentStatus is the state of a payment of an order
+type PaymentStatus string
+
+const (
+	PaymentStatusPending           PaymentStatus = "Pending" // Sent to the provider, no answer recorded yet
+	PaymentStatusAuthorized        PaymentStatus = "Authorized"
+	PaymentStatusDeclined          PaymentStatus = "Declined"
+	PaymentStatusFailed            PaymentStatus = "Failed" // The provider did not answer in time
+	PaymentStatusCaptured          PaymentStatus = "Captured"
+	PaymentStatusVoided            PaymentStatus = "Voided"
+	PaymentStatusPartiallyRefunded PaymentStatus = "PartiallyRefunded"
+	PaymentStatusRefunded          PaymentStatus = "Refunded"
+)
+
+// paymentTransitions lists the status a payment may move to next; Declined, Failed, Voided and Refunded
+// are terminal
+var paymentTransitions = map[PaymentStatus][]PaymentStatus{
+	PaymentStatusPending:           {PaymentStatusAuthorized, PaymentStatusDeclined, PaymentStatusFailed},
+	PaymentStatusAuthorized:        {PaymentStatusCaptured, PaymentStatusVoided},
+	PaymentStatusCaptured:          {PaymentStatusPartiallyRefunded, PaymentStatusRefunded},
+	PaymentStatusPartiallyRefunded: {PaymentStatusPartiallyRefunded, PaymentStatusRefunded},
+}
+
+// CanTransitionTo reports whether a payment in status s may move to target
+func (s PaymentStatus) CanTransitionTo(target PaymentStatus) bool {
+	for _, next := range paymentTransitions[s] {
+		if next == target {
+			return true
+		}
+	}
+	return false
+}
+
+// SecuresOrder reports whether a payment in status s holds or has taken the customer's money, so the
+// order may be confirmed
+func (s PaymentStatus) SecuresOrder() bool {
+	return s == PaymentStatusAuthorized || s == PaymentStatusCaptured
+}
+
+var paymentStatusToPb = map[PaymentStatus]pb.PaymentStatus{
+	PaymentStatusPending:           pb.PaymentStatus_PAYMENT_STATUS_PENDING,
+	PaymentStatusAuthorized:        pb.PaymentStatus_PAYMENT_STATUS_AUTHORIZED,
+	PaymentStatusDeclined:          pb.PaymentStatus_PAYMENT_STATUS_DECLINED,
+	PaymentStatusFailed:            pb.PaymentStatus_PAYMENT_STATUS_FAILED,
+	PaymentStatusCaptured:          pb.PaymentStatus_PAYMENT_STATUS_CAPTURED,
+	PaymentStatusVoided:            pb.PaymentStatus_PAYMENT_STATUS_VOIDED,
+	PaymentStatusPartiallyRefunded: pb.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED,
+	PaymentStatusRefunded:          pb.PaymentStatus_PAYMENT_STATUS_REFUNDED,
+}
+
+// PaymentOperation is a request sent to a payment provider
+type PaymentOperation string
+
+const (
+	PaymentOperationAuthorize PaymentOperation = "authorize"
+	PaymentOperationCapture   PaymentOperation = "capture"
+	PaymentOperationVoid      PaymentOperation = "void"
+	PaymentOperationRefund    PaymentOperation = "refund"
+)
+
+// PaymentAttemptResult is how the provider answered an operation
+type PaymentAttemptResult string
+
+const (
+	PaymentAttemptPending   PaymentAttemptResult = "pending" // Sent to the provider, no answer recorded yet
+	PaymentAttemptSucceeded PaymentAttemptResult = "succeeded"
+	PaymentAttemptDeclined  PaymentAttemptResult = "declined"
+	PaymentAttemptFailed    PaymentAttemptResult = "failed" // No answer, e.g. a timeout
+)
+
+// Payment is money authorized for an order through a payment provider
+type Payment struct {
+	ID             int32            `json:"id"`
+	OrderID        int32            `json:"order_id" gorm:"index"`
+	Provider       string           `json:"provider"`
+	Reference      string           `json:"reference"` // Provider reference of the authorization
+	Status         PaymentStatus    `json:"status"`
+	Amount         Money            `json:"amount" gorm:"embedded;embeddedPrefix:amount_"` // Authorized
+	CapturedAmount Money            `json:"captured_amount" gorm:"embedded;embeddedPrefix:captured_"`
+	RefundedAmount Money            `json:"refunded_amount" gorm:"embedded;embeddedPrefix:refunded_"`
+	FailureReason  string           `json:"failure_reason"`
+	CheckoutID     *int32           `json:"checkout_id" gorm:"index"` // Checkout saga that authorized the payment, if any
+	Attempts       []PaymentAttempt `json:"attempts"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
+
+// Refundable returns the captured money that was not refunded yet
+func (p *Payment) Refundable() Money {
+	return NewMoney(p.CapturedAmount.MinorUnits-p.RefundedAmount.MinorUnits, p.CapturedAmount.Currency)
+}
+
+// PaymentAttempt records one operation sent to the provider for a payment and its outcome
+type PaymentAttempt struct {
+	ID        int32                `json:"id"`
+	PaymentID int32                `json:"payment_id" gorm:"index"`
+	Operation PaymentOperation     `json:"operation"`
+	Result    PaymentAttemptResult `json:"result"`
+	Amount    Money                `json:"amount" gorm:"embedded;embeddedPrefix:amount_"`
+	Error     string               `json:"error"` // Decline reason or error of a failed attempt
+	CreatedAt time.Time            `json:"created_at"`
+}
+
+// ToPb converts the Payment model and its loaded attempts to the protobuf Payment
+func (p *Payment) ToPb() *pb.Payment {
+	response := &pb.Payment{
+		Id:             p.ID,
+		OrderId:        p.OrderID,
+		Provider:       p.Provider,
+		Reference:      p.Reference,
+		Status:         paymentStatusToPb[p.Status],
+		Amount:         p.Amount.ToPb(),
+		CapturedAmount: p.CapturedAmount.ToPb(),
+		RefundedAmount: p.RefundedAmount.ToPb(),
+		FailureReason:  p.FailureReason,
+		CreatedAt:      p.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      p.UpdatedAt.Format(time.RFC3339),
+	}
+	for _, attempt := range p.Attempts {
+		response.Attempts = append(response.Attempts, &pb.PaymentAttempt{
+			Operation: string(attempt.Operation),
+			Result:    string(attempt.Result),
+			Amount:    attempt.Amount.ToPb(),
+			Error:     attempt.Error,
+			CreatedAt: attempt.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return response
+}
@@ -0,0 +1,98 @@
+package models
+
+import (
+	"time"
+
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+)
+
+// ReturnStatus is the state of a return merchandise authorization
+type ReturnStatus string
+
+const (
+	ReturnStatusRequested ReturnStatus = "Requested"
+	ReturnStatusApproved  ReturnStatus = "Approved"
+	ReturnStatusRejected  ReturnStatus = "Rejected"
+	ReturnStatusReceived  ReturnStatus = "Received"
+	ReturnStatusRefunded  ReturnStatus = "Refunded"
+)
+
+// returnTransitions lists the status a return may move to next; Rejected and Refunded are terminal
+var returnTransitions = map[ReturnStatus][]ReturnStatus{
+	ReturnStatusRequested: {ReturnStatusApproved, ReturnStatusRejected},
+	ReturnStatusApproved:  {ReturnStatusReceived},
+	ReturnStatusReceived:  {ReturnStatusRefunded},
+}
+
+// CanTransitionTo reports whether a return in status s may move to target
+func (s ReturnStatus) CanTransitionTo(target ReturnStatus) bool {
+	for _, next := range returnTransitions[s] {
+		if next == target {
+			return true
+		}
+	}
+	return false
+}
+
+var returnStatusToPb = map[ReturnStatus]pb.ReturnStatus{
+	ReturnStatusRequested: pb.ReturnStatus_RETURN_STATUS_REQUESTED,
+	ReturnStatusApproved:  pb.ReturnStatus_RETURN_STATUS_APPROVED,
+	ReturnStatusRejected:  pb.ReturnStatus_RETURN_STATUS_REJECTED,
+	ReturnStatusReceived:  pb.ReturnStatus_RETURN_STATUS_RECEIVED,
+	ReturnStatusRefunded:  pb.ReturnStatus_RETURN_STATUS_REFUNDED,
+}
+
+// Return is a return merchandise authorization (RMA) against a delivered order
+type Return struct {
+	ID              int32        `json:"id"`
+	OrderID         int32        `json:"order_id" gorm:"index"`
+	Status          ReturnStatus `json:"status"`
+	Lines           []ReturnLine `json:"lines"`
+	RefundAmount    Money        `json:"refund_amount" gorm:"embedded;embeddedPrefix:refund_"` // Sum of the line refunds
+	Restocked       bool         `json:"restocked"`
+	Note            string       `json:"note"`
+	RefundedAt      *time.Time   `json:"refunded_at"`
+	PaymentID       *int32       `json:"payment_id"`        // Payment the refund was made from
+	RefundAttemptID *int32       `json:"refund_attempt_id"` // Payment attempt that makes the refund
+	CreatedAt       time.Time    `json:"created_at"`
+	UpdatedAt       time.Time    `json:"updated_at"`
+}
+
+// ReturnLine is a quantity of an order's item being returned
+type ReturnLine struct {
+	ID           int32  `json:"id"`
+	ReturnID     int32  `json:"return_id" gorm:"index"`
+	ItemID       int32  `json:"item_id"`
+	Quantity     int32  `json:"quantity"`
+	Reason       string `json:"reason"`
+	RefundAmount Money  `json:"refund_amount" gorm:"embedded;embeddedPrefix:refund_"` // Paid for the units, after discounts and including tax
+}
+
+// ToPb converts the Return model and its loaded lines to the protobuf Return
+func (r *Return) ToPb() *pb.Return {
+	response := &pb.Return{
+		Id:           r.ID,
+		OrderId:      r.OrderID,
+		Status:       returnStatusToPb[r.Status],
+		RefundAmount: r.RefundAmount.ToPb(),
+		Restocked:    r.Restocked,
+		Note:         r.Note,
+		CreatedAt:    r.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    r.UpdatedAt.Format(time.RFC3339),
+	}
+	if r.RefundedAt != nil {
+		response.RefundedAt = r.RefundedAt.Format(time.RFC3339)
+	}
+	if r.PaymentID != nil {
+		response.PaymentId = *r.PaymentID
+	}
+	for _, line := range r.Lines {
+		response.Lines = append(response.Lines, &pb.ReturnLine{
+			ItemId:       line.ItemID,
+			Quantity:     line.Quantity,
+			Reason:       line.Reason,
+			RefundAmount: line.RefundAmount.ToPb(),
+		})
+	}
+	return response
+}
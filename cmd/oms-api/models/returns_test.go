@@ -0,0 +1,20 @@
+package models
+
+import "testing"
+
+func TestReturnStatusCanTransitionTo(t *testing.T) {
+	statuses := []ReturnStatus{ReturnStatusRequested, ReturnStatusApproved, ReturnStatusRejected, ReturnStatusReceived, ReturnStatusRefunded}
+	allowed := map[[2]ReturnStatus]bool{
+		{ReturnStatusRequested, ReturnStatusApproved}: true,
+		{ReturnStatusRequested, ReturnStatusRejected}: true,
+		{ReturnStatusApproved, ReturnStatusReceived}:  true,
+		{ReturnStatusReceived, ReturnStatusRefunded}:  true,
+	}
+	for _, from := range statuses {
+		for _, to := range statuses {
+			if got, want := from.CanTransitionTo(to), allowed[[2]ReturnStatus{from, to}]; got != want {
+				t.Errorf("%s.CanTransitionTo(%s) = %v, want %v", from, to, got, want)
+			}
+		}
+	}
+}
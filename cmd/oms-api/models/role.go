@@ -0,0 +1,115 @@
+package models
+
+import pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+
+// Role is what a user is allowed to do in the OMS
+type Role string
+
+const (
+	RoleCustomer Role = "customer" // Shops for themselves; the role of every new user
+	RoleSupport  Role = "support"  // Helps customers with their orders and returns
+	RoleAdmin    Role = "admin"    // Manages the catalogue, pricing, orders and users
+	RoleService  Role = "service"  // Another system, such as the warehouse or billing
+)
+
+// Permission is an operation a role may perform. Permissions ending in ".own" only cover the caller's
+// own user and orders.
+type Permission string
+
+const (
+	PermItemsRead        Permission = "items.read"
+	PermItemsWrite       Permission = "items.write"
+	PermStockWrite       Permission = "stock.write"
+	PermPricingRead      Permission = "pricing.read" // Discounts, coupons, exchange rates and taxes
+	PermPricingWrite     Permission = "pricing.write"
+	PermUsersRead        Permission = "users.read"
+	PermUsersReadOwn     Permission = "users.read.own"
+	PermUsersWrite       Permission = "users.write"
+	PermUsersWriteOwn    Permission = "users.write.own"
+	PermRolesManage      Permission = "roles.manage"
+	PermOrdersRead       Permission = "orders.read" // Also their shipments, returns and payments
+	PermOrdersReadOwn    Permission = "orders.read.own"
+	PermOrdersWrite      Permission = "orders.write"
+	PermOrdersWriteOwn   Permission = "orders.write.own"
+	PermFulfillmentWrite Permission = "fulfillment.write"
+	PermReturnsWrite     Permission = "returns.write"
+	PermReturnsWriteOwn  Permission = "returns.write.own" // Request returns of their own orders
+	PermPaymentsWrite    Permission = "payments.write"
+	PermEventsRead       Permission = "events.read"
+	PermWebhooksManage   Permission = "webhooks.manage"
+	PermAPIKeysManage    Permission = "api_keys.manage"
+)
+
+// Permissions lists every permission
+var Permissions = []Permission{
+	PermItemsRead, PermItemsWrite, PermStockWrite, PermPricingRead, PermPricingWrite,
+	PermUsersRead, PermUsersReadOwn, PermUsersWrite, PermUsersWriteOwn, PermRolesManage,
+	PermOrdersRead, PermOrdersReadOwn, PermOrdersWrite, PermOrdersWriteOwn,
+	PermFulfillmentWrite, PermReturnsWrite, PermReturnsWriteOwn, PermPaymentsWrite, PermEventsRead, PermWebhooksManage,
+	PermAPIKeysManage,
+}
+
+// rolePermissions is the single source of truth for what each role may do
+var rolePermissions = map[Role][]Permission{
+	RoleCustomer: {
+		PermItemsRead, PermUsersReadOwn, PermUsersWriteOwn, PermOrdersReadOwn, PermOrdersWriteOwn, PermReturnsWriteOwn,
+	},
+	RoleSupport: {
+		PermItemsRead, PermPricingRead, PermUsersRead, PermOrdersRead, PermOrdersWrite, PermReturnsWrite,
+	},
+	RoleAdmin: {
+		PermItemsRead, PermItemsWrite, PermStockWrite, PermPricingRead, PermPricingWrite,
+		PermUsersRead, PermUsersWrite, PermRolesManage, PermOrdersRead, PermOrdersWrite,
+		PermFulfillmentWrite, PermReturnsWrite, PermPaymentsWrite, PermEventsRead, PermWebhooksManage,
+		PermAPIKeysManage,
+	},
+	RoleService: {
+		PermItemsRead, PermStockWrite, PermPricingRead, PermUsersRead, PermOrdersRead, PermOrdersWrite,
+		PermFulfillmentWrite, PermPaymentsWrite, PermEventsRead,
+	},
+}
+
+// Can reports whether role r has permission p
+func (r Role) Can(p Permission) bool {
+	return PermissionsAllow(rolePermissions[r], p)
+}
+
+// PermissionsAllow reports whether the granted permissions include p. A permission covering everyone's
+// resources implies the ".own" one.
+func PermissionsAllow(granted []Permission, p Permission) bool {
+	for _, permission := range granted {
+		if permission == p || permission+".own" == p {
+			return true
+		}
+	}
+	return false
+}
+
+var roleToPb = map[Role]pb.Role{
+	RoleCustomer: pb.Role_ROLE_CUSTOMER,
+	RoleSupport:  pb.Role_ROLE_SUPPORT,
+	RoleAdmin:    pb.Role_ROLE_ADMIN,
+	RoleService:  pb.Role_ROLE_SERVICE,
+}
+
+// ToPb converts the role to its protobuf enum value
+func (r Role) ToPb() pb.Role {
+	return roleToPb[r]
+}
+
+// ParseRole returns the role named value
+func ParseRole(value string) (Role, bool) {
+	_, ok := roleToPb[Role(value)]
+	return Role(value), ok
+}
+
+// RoleFromPb converts a protobuf enum value to a Role.
+// It returns false for ROLE_UNSPECIFIED and unknown values.
+func RoleFromPb(value pb.Role) (Role, bool) {
+	for r, v := range roleToPb {
+		if v == value {
+			return r, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,46 @@
+package models
+
+import "testing"
+
+func TestPermissionsAllow(t *testing.T) {
+	for _, test := range []struct {
+		granted    []Permission
+		permission Permission
+		want       bool
+	}{
+		{[]Permission{PermOrdersWrite}, PermOrdersWrite, true},
+		{[]Permission{PermOrdersWrite}, PermOrdersWriteOwn, true},
+		{[]Permission{PermOrdersWriteOwn}, PermOrdersWriteOwn, true},
+		{[]Permission{PermOrdersWriteOwn}, PermOrdersWrite, false},
+		{[]Permission{PermOrdersRead}, PermOrdersWrite, false},
+		{[]Permission{PermReturnsWrite}, PermReturnsWriteOwn, true},
+		{nil, PermItemsRead, false},
+	} {
+		if got := PermissionsAllow(test.granted, test.permission); got != test.want {
+			t.Errorf("PermissionsAllow(%v, %s) = %v, want %v", test.granted, test.permission, got, test.want)
+		}
+	}
+}
+
+func TestRoleCan(t *testing.T) {
+	for _, test := range []struct {
+		role       Role
+		permission Permission
+		want       bool
+	}{
+		{RoleCustomer, PermOrdersWriteOwn, true},
+		{RoleCustomer, PermOrdersWrite, false},
+		{RoleCustomer, PermReturnsWriteOwn, true},
+		{RoleCustomer, PermReturnsWrite, false},
+		{RoleSupport, PermReturnsWriteOwn, true},
+		{RoleSupport, PermPaymentsWrite, false},
+		{RoleService, PermFulfillmentWrite, true},
+		{RoleService, PermRolesManage, false},
+		{RoleAdmin, PermAPIKeysManage, true},
+		{Role("unknown"), PermItemsRead, false},
+	} {
+		if got := test.role.Can(test.permission); got != test.want {
+			t.Errorf("%s.Can(%s) = %v, want %v", test.role, test.permission, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// Session is a login of a user. Its access tokens are valid while it is, and its refresh token, stored
+// as a SHA-256 hash, is replaced on every refresh.
+type Session struct {
+	ID               int32      `json:"id"`
+	UserID           int32      `json:"user_id" gorm:"index"`
+	RefreshTokenHash string     `json:"-" gorm:"uniqueIndex"`
+	ExpiresAt        time.Time  `json:"expires_at"`
+	RevokedAt        *time.Time `json:"revoked_at"` // Set on logout and password changes
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// Active reports whether the session can still be used at now
+func (s *Session) Active(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+)
+
+// Shipment is a parcel sent for an order. Orders may be sent in several shipments, each carrying part
+// of the ordered quantities.
+type Shipment struct {
+	ID             int32          `json:"id"`
+	OrderID        int32          `json:"order_id" gorm:"index"`
+	Carrier        string         `json:"carrier"`
+	TrackingNumber string         `json:"tracking_number"`
+	ShippedAt      time.Time      `json:"shipped_at"`
+	DeliveredAt    *time.Time     `json:"delivered_at"` // Nil until delivered
+	Items          []ShipmentItem `json:"items"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+// ShipmentItem is a quantity of an order's item sent in a shipment
+type ShipmentItem struct {
+	ID         int32 `json:"id"`
+	ShipmentID int32 `json:"shipment_id" gorm:"index"`
+	ItemID     int32 `json:"item_id"`
+	Quantity   int32 `json:"quantity"`
+}
+
+// ToPb converts the Shipment model and its loaded items to the protobuf Shipment
+func (s *Shipment) ToPb() *pb.Shipment {
+	shipment := &pb.Shipment{
+		Id:             s.ID,
+		OrderId:        s.OrderID,
+		Carrier:        s.Carrier,
+		TrackingNumber: s.TrackingNumber,
+		ShippedAt:      s.ShippedAt.Format(time.RFC3339),
+		CreatedAt:      s.CreatedAt.Format(time.RFC3339),
+	}
+	if s.DeliveredAt != nil {
+		shipment.DeliveredAt = s.DeliveredAt.Format(time.RFC3339)
+	}
+	for _, item := range s.Items {
+		shipment.Items = append(shipment.Items, &pb.ShipmentItem{ItemId: item.ItemID, Quantity: item.Quantity})
+	}
+	return shipment
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+)
+
+// TaxJurisdiction collects the tax rates charged on orders shipped to a region
+type TaxJurisdiction struct {
+	ID        int32     `json:"id"`
+	Region    string    `json:"region" gorm:"uniqueIndex"` // e.g. "US-CA", or a country code covering the whole country
+	Name      string    `json:"name"`
+	Rates     []TaxRate `json:"rates" gorm:"foreignKey:JurisdictionID"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TaxRate is the rate a jurisdiction charges on items of a tax category
+type TaxRate struct {
+	ID             int32     `json:"id"`
+	JurisdictionID int32     `json:"jurisdiction_id" gorm:"uniqueIndex:idx_tax_rates_jurisdiction_category"`
+	TaxCategory    string    `json:"tax_category" gorm:"uniqueIndex:idx_tax_rates_jurisdiction_category"` // Empty for the standard rate
+	Rate           float64   `json:"rate"`                                                                // Percentage, e.g. 7.25 for 7.25%
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// NormalizeTaxRegion trims a region code and returns it upper case
+func NormalizeTaxRegion(region string) string {
+	return strings.ToUpper(strings.TrimSpace(region))
+}
+
+// TaxRegionCandidates returns the regions whose jurisdiction applies to region, most specific first,
+// e.g. "US-CA" and then "US"
+func TaxRegionCandidates(region string) []string {
+	candidates := []string{region}
+	if country, _, found := strings.Cut(region, "-"); found && country != "" {
+		candidates = append(candidates, country)
+	}
+	return candidates
+}
+
+// NormalizeTaxCategory trims a tax category and returns it lower case
+func NormalizeTaxCategory(category string) string {
+	return strings.ToLower(strings.TrimSpace(category))
+}
+
+// TaxOn returns the tax on an amount of minor units at a rate, rounded half away from zero to the minor
+// unit. When the amount includes tax, the tax is the part of it above amount / (1 + rate).
+func TaxOn(minorUnits int64, rate float64, includesTax bool) int64 {
+	if includesTax {
+		return int64(math.Round(float64(minorUnits) * rate / (100 + rate)))
+	}
+	return PercentOf(minorUnits, rate)
+}
+
+// ToPb converts the TaxJurisdiction model and its loaded rates to the protobuf TaxJurisdiction
+func (j *TaxJurisdiction) ToPb() *pb.TaxJurisdiction {
+	jurisdiction := &pb.TaxJurisdiction{Region: j.Region, Name: j.Name}
+	for _, rate := range j.Rates {
+		jurisdiction.Rates = append(jurisdiction.Rates, &pb.TaxRate{TaxCategory: rate.TaxCategory, Rate: rate.Rate})
+	}
+	return jurisdiction
+}
+
+// TaxRateFromPb validates a protobuf TaxRate and converts it to the model
+func TaxRateFromPb(rate *pb.TaxRate) (TaxRate, error) {
+	if rate == nil {
+		return TaxRate{}, fmt.Errorf("rate is required")
+	}
+	if rate.GetRate() < 0 || rate.GetRate() >= 100 || math.IsNaN(rate.GetRate()) {
+		return TaxRate{}, fmt.Errorf("rate must be a percentage from 0 up to 100")
+	}
+	return TaxRate{TaxCategory: NormalizeTaxCategory(rate.GetTaxCategory()), Rate: rate.GetRate()}, nil
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTaxOn(t *testing.T) {
+	for _, test := range []struct {
+		minorUnits  int64
+		rate        float64
+		includesTax bool
+		want        int64
+	}{
+		{10000, 20, false, 2000},
+		{10000, 20, true, 1667}, // 1666.67 of 100.00 gross
+		{12000, 20, true, 2000},
+		{999, 7.25, false, 72}, // 72.43
+		{1075, 7.5, true, 75},
+		{10000, 0, true, 0},
+		{-1000, 20, true, -167}, // Credits round away from zero too
+	} {
+		if got := TaxOn(test.minorUnits, test.rate, test.includesTax); got != test.want {
+			t.Errorf("TaxOn(%d, %v, %v) = %d, want %d", test.minorUnits, test.rate, test.includesTax, got, test.want)
+		}
+	}
+}
+
+func TestTaxRegionCandidates(t *testing.T) {
+	for region, want := range map[string][]string{
+		"US-CA": {"US-CA", "US"},
+		"DE":    {"DE"},
+		"-CA":   {"-CA"},
+	} {
+		if got := TaxRegionCandidates(region); !reflect.DeepEqual(got, want) {
+			t.Errorf("TaxRegionCandidates(%q) = %v, want %v", region, got, want)
+		}
+	}
+}
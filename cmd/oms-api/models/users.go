@@ -9,13 +9,15 @@ import (
 
 // User represents a user in the OMS system
 type User struct {
-	ID        int32          `json:"id"`
-	Name      string         `json:"name"`
-	Email     string         `json:"email"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `json:"deleted_at"`
-	Orders    []Order        `gorm:"foreignKey:UserID"` // Ensure the foreign key is correctly set
+	ID           int32          `json:"id"`
+	Name         string         `json:"name"`
+	Email        string         `json:"email"`
+	PasswordHash string         `json:"-"` // bcrypt; empty for users who cannot log in
+	Role         Role           `json:"role" gorm:"default:customer"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `json:"deleted_at"`
+	Orders       []Order        `gorm:"foreignKey:UserID"` // Ensure the foreign key is correctly set
 
 }
 
@@ -33,6 +35,7 @@ func (u *User) ToPb() *pb.User {
 		CreatedAt: u.CreatedAt.String(),
 		UpdatedAt: u.UpdatedAt.String(),
 		DeletedAt: u.DeletedAt.Time.String(),
+		Role:      u.Role.ToPb(),
 	}
 }
 
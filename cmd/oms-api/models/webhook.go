@@ -0,0 +1,128 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	pb "github.com/keyurKalariya/OMS/cmd/oms-api/protobuf"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+// WebhookSubscription is a partner endpoint that receives the events of the given types over HTTP
+type WebhookSubscription struct {
+	ID         int32          `json:"id"`
+	URL        string         `json:"url"`
+	EventTypes string         `json:"event_types"` // Comma-separated event types
+	Secret     string         `json:"-"`           // Key of the HMAC signature of every delivery
+	Active     bool           `json:"active"`      // Inactive subscriptions get no new deliveries and hold their pending ones
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+	DeletedAt  gorm.DeletedAt `json:"deleted_at"`
+}
+
+// Types returns the event types the subscription receives
+func (w *WebhookSubscription) Types() []EventType {
+	var types []EventType
+	for _, value := range strings.Split(w.EventTypes, ",") {
+		if value != "" {
+			types = append(types, EventType(value))
+		}
+	}
+	return types
+}
+
+// Receives reports whether the subscription receives events of eventType
+func (w *WebhookSubscription) Receives(eventType EventType) bool {
+	for _, value := range w.Types() {
+		if value == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// ToPb converts the WebhookSubscription model to the protobuf WebhookSubscription; the secret is left out
+func (w *WebhookSubscription) ToPb() *pb.WebhookSubscription {
+	response := &pb.WebhookSubscription{
+		Id:        w.ID,
+		Url:       w.URL,
+		Active:    proto.Bool(w.Active),
+		CreatedAt: w.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: w.UpdatedAt.Format(time.RFC3339),
+	}
+	for _, value := range w.Types() {
+		response.EventTypes = append(response.EventTypes, string(value))
+	}
+	return response
+}
+
+// WebhookDeliveryStatus is the state of the delivery of an event to a subscription
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "Pending" // Waiting for its next attempt
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "Delivered"
+	WebhookDeliveryDead      WebhookDeliveryStatus = "Dead" // Gave up after the maximum number of attempts
+)
+
+var webhookDeliveryStatusToPb = map[WebhookDeliveryStatus]pb.WebhookDeliveryStatus{
+	WebhookDeliveryPending:   pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING,
+	WebhookDeliveryDelivered: pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED,
+	WebhookDeliveryDead:      pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD,
+}
+
+// WebhookDeliveryStatusFromPb converts a protobuf enum value to a WebhookDeliveryStatus.
+// It returns false for WEBHOOK_DELIVERY_STATUS_UNSPECIFIED and unknown values.
+func WebhookDeliveryStatusFromPb(value pb.WebhookDeliveryStatus) (WebhookDeliveryStatus, bool) {
+	for s, v := range webhookDeliveryStatusToPb {
+		if v == value {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// WebhookDelivery is an outbox event queued for a subscription, with the state of its attempts
+type WebhookDelivery struct {
+	ID             int32                 `json:"id"`
+	SubscriptionID int32                 `json:"subscription_id" gorm:"index"`
+	EventSequence  int64                 `json:"event_sequence"`
+	EventType      EventType             `json:"event_type"`
+	Status         WebhookDeliveryStatus `json:"status" gorm:"index"`
+	Attempts       int32                 `json:"attempts"`
+	NextAttemptAt  time.Time             `json:"next_attempt_at" gorm:"index"`
+	LastStatusCode int32                 `json:"last_status_code"` // HTTP status of the last attempt; 0 when no response came back
+	LastError      string                `json:"last_error"`
+	DeliveredAt    *time.Time            `json:"delivered_at"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+// ToPb converts the WebhookDelivery model to the protobuf WebhookDelivery
+func (d *WebhookDelivery) ToPb() *pb.WebhookDelivery {
+	response := &pb.WebhookDelivery{
+		Id:             d.ID,
+		SubscriptionId: d.SubscriptionID,
+		EventSequence:  d.EventSequence,
+		EventType:      string(d.EventType),
+		Status:         webhookDeliveryStatusToPb[d.Status],
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt.Format(time.RFC3339),
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      d.UpdatedAt.Format(time.RFC3339),
+	}
+	if d.DeliveredAt != nil {
+		response.DeliveredAt = d.DeliveredAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+// WebhookCursor remembers up to which outbox sequence events were queued for the subscriptions
+type WebhookCursor struct {
+	ID           int32     `json:"id"` // Always 1
+	LastSequence int64     `json:"last_sequence"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
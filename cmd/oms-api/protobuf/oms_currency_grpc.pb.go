@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: oms_currency.proto
+
+package protobuf
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	CurrencyService_SetExchangeRate_FullMethodName     = "/CurrencyService/SetExchangeRate"
+	CurrencyService_GetAllExchangeRates_FullMethodName = "/CurrencyService/GetAllExchangeRates"
+	CurrencyService_ImportExchangeRates_FullMethodName = "/CurrencyService/ImportExchangeRates"
+	CurrencyService_DeleteExchangeRate_FullMethodName  = "/CurrencyService/DeleteExchangeRate"
+)
+
+// CurrencyServiceClient is the client API for CurrencyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CurrencyServiceClient interface {
+	SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest, opts ...grpc.CallOption) (*ExchangeRate, error)
+	GetAllExchangeRates(ctx context.Context, in *GetAllExchangeRatesRequest, opts ...grpc.CallOption) (*GetAllExchangeRatesResponse, error)
+	ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ImportExchangeRatesResponse, error)
+	DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateRequest, opts ...grpc.CallOption) (*DeleteExchangeRateResponse, error)
+}
+
+type currencyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCurrencyServiceClient(cc grpc.ClientConnInterface) CurrencyServiceClient {
+	return &currencyServiceClient{cc}
+}
+
+func (c *currencyServiceClient) SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest, opts ...grpc.CallOption) (*ExchangeRate, error) {
+	out := new(ExchangeRate)
+	err := c.cc.Invoke(ctx, CurrencyService_SetExchangeRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyServiceClient) GetAllExchangeRates(ctx context.Context, in *GetAllExchangeRatesRequest, opts ...grpc.CallOption) (*GetAllExchangeRatesResponse, error) {
+	out := new(GetAllExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, CurrencyService_GetAllExchangeRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyServiceClient) ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ImportExchangeRatesResponse, error) {
+	out := new(ImportExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, CurrencyService_ImportExchangeRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyServiceClient) DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateRequest, opts ...grpc.CallOption) (*DeleteExchangeRateResponse, error) {
+	out := new(DeleteExchangeRateResponse)
+	err := c.cc.Invoke(ctx, CurrencyService_DeleteExchangeRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CurrencyServiceServer is the server API for CurrencyService service.
+// All implementations must embed UnimplementedCurrencyServiceServer
+// for forward compatibility
+type CurrencyServiceServer interface {
+	SetExchangeRate(context.Context, *SetExchangeRateRequest) (*ExchangeRate, error)
+	GetAllExchangeRates(context.Context, *GetAllExchangeRatesRequest) (*GetAllExchangeRatesResponse, error)
+	ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesResponse, error)
+	DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*DeleteExchangeRateResponse, error)
+	mustEmbedUnimplementedCurrencyServiceServer()
+}
+
+// UnimplementedCurrencyServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCurrencyServiceServer struct {
+}
+
+func (UnimplementedCurrencyServiceServer) SetExchangeRate(context.Context, *SetExchangeRateRequest) (*ExchangeRate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeRate not implemented")
+}
+func (UnimplementedCurrencyServiceServer) GetAllExchangeRates(context.Context, *GetAllExchangeRatesRequest) (*GetAllExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllExchangeRates not implemented")
+}
+func (UnimplementedCurrencyServiceServer) ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportExchangeRates not implemented")
+}
+func (UnimplementedCurrencyServiceServer) DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*DeleteExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExchangeRate not implemented")
+}
+func (UnimplementedCurrencyServiceServer) mustEmbedUnimplementedCurrencyServiceServer() {}
+
+// UnsafeCurrencyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CurrencyServiceServer will
+// result in compilation errors.
+type UnsafeCurrencyServiceServer interface {
+	mustEmbedUnimplementedCurrencyServiceServer()
+}
+
+func RegisterCurrencyServiceServer(s grpc.ServiceRegistrar, srv CurrencyServiceServer) {
+	s.RegisterService(&CurrencyService_ServiceDesc, srv)
+}
+
+func _CurrencyService_SetExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServiceServer).SetExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CurrencyService_SetExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServiceServer).SetExchangeRate(ctx, req.(*SetExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyService_GetAllExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServiceServer).GetAllExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CurrencyService_GetAllExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServiceServer).GetAllExchangeRates(ctx, req.(*GetAllExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyService_ImportExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServiceServer).ImportExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CurrencyService_ImportExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServiceServer).ImportExchangeRates(ctx, req.(*ImportExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyService_DeleteExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServiceServer).DeleteExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CurrencyService_DeleteExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServiceServer).DeleteExchangeRate(ctx, req.(*DeleteExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CurrencyService_ServiceDesc is the grpc.ServiceDesc for CurrencyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CurrencyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "CurrencyService",
+	HandlerType: (*CurrencyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetExchangeRate",
+			Handler:    _CurrencyService_SetExchangeRate_Handler,
+		},
+		{
+			MethodName: "GetAllExchangeRates",
+			Handler:    _CurrencyService_GetAllExchangeRates_Handler,
+		},
+		{
+			MethodName: "ImportExchangeRates",
+			Handler:    _CurrencyService_ImportExchangeRates_Handler,
+		},
+		{
+			MethodName: "DeleteExchangeRate",
+			Handler:    _CurrencyService_DeleteExchangeRate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "oms_currency.proto",
+}
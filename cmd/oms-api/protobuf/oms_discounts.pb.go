@@ -0,0 +1,1667 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.21.12
+// source: oms_discounts.proto
+
+package protobuf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DiscountKind says how the value of a rule is applied
+type DiscountKind int32
+
+const (
+	DiscountKind_DISCOUNT_KIND_UNSPECIFIED DiscountKind = 0
+	DiscountKind_DISCOUNT_KIND_PERCENTAGE  DiscountKind = 1 // value is a percentage, e.g. 15 for 15%
+	DiscountKind_DISCOUNT_KIND_FIXED       DiscountKind = 2 // amount is taken off the order, or off each unit for line rules
+)
+
+// Enum value maps for DiscountKind.
+var (
+	DiscountKind_name = map[int32]string{
+		0: "DISCOUNT_KIND_UNSPECIFIED",
+		1: "DISCOUNT_KIND_PERCENTAGE",
+		2: "DISCOUNT_KIND_FIXED",
+	}
+	DiscountKind_value = map[string]int32{
+		"DISCOUNT_KIND_UNSPECIFIED": 0,
+		"DISCOUNT_KIND_PERCENTAGE":  1,
+		"DISCOUNT_KIND_FIXED":       2,
+	}
+)
+
+func (x DiscountKind) Enum() *DiscountKind {
+	p := new(DiscountKind)
+	*p = x
+	return p
+}
+
+func (x DiscountKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiscountKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_oms_discounts_proto_enumTypes[0].Descriptor()
+}
+
+func (DiscountKind) Type() protoreflect.EnumType {
+	return &file_oms_discounts_proto_enumTypes[0]
+}
+
+func (x DiscountKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiscountKind.Descriptor instead.
+func (DiscountKind) EnumDescriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{0}
+}
+
+// DiscountScope says whether a rule discounts the whole order or each matching line
+type DiscountScope int32
+
+const (
+	DiscountScope_DISCOUNT_SCOPE_UNSPECIFIED DiscountScope = 0
+	DiscountScope_DISCOUNT_SCOPE_ORDER       DiscountScope = 1
+	DiscountScope_DISCOUNT_SCOPE_LINE        DiscountScope = 2
+)
+
+// Enum value maps for DiscountScope.
+var (
+	DiscountScope_name = map[int32]string{
+		0: "DISCOUNT_SCOPE_UNSPECIFIED",
+		1: "DISCOUNT_SCOPE_ORDER",
+		2: "DISCOUNT_SCOPE_LINE",
+	}
+	DiscountScope_value = map[string]int32{
+		"DISCOUNT_SCOPE_UNSPECIFIED": 0,
+		"DISCOUNT_SCOPE_ORDER":       1,
+		"DISCOUNT_SCOPE_LINE":        2,
+	}
+)
+
+func (x DiscountScope) Enum() *DiscountScope {
+	p := new(DiscountScope)
+	*p = x
+	return p
+}
+
+func (x DiscountScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiscountScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_oms_discounts_proto_enumTypes[1].Descriptor()
+}
+
+func (DiscountScope) Type() protoreflect.EnumType {
+	return &file_oms_discounts_proto_enumTypes[1]
+}
+
+func (x DiscountScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiscountScope.Descriptor instead.
+func (DiscountScope) EnumDescriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{1}
+}
+
+// DiscountStacking says whether a rule may be combined with other rules
+type DiscountStacking int32
+
+const (
+	DiscountStacking_DISCOUNT_STACKING_UNSPECIFIED DiscountStacking = 0
+	DiscountStacking_DISCOUNT_STACKING_STACKABLE   DiscountStacking = 1 // Applies together with other stackable rules
+	DiscountStacking_DISCOUNT_STACKING_EXCLUSIVE   DiscountStacking = 2 // Applies alone, and only if no higher priority rule applied
+)
+
+// Enum value maps for DiscountStacking.
+var (
+	DiscountStacking_name = map[int32]string{
+		0: "DISCOUNT_STACKING_UNSPECIFIED",
+		1: "DISCOUNT_STACKING_STACKABLE",
+		2: "DISCOUNT_STACKING_EXCLUSIVE",
+	}
+	DiscountStacking_value = map[string]int32{
+		"DISCOUNT_STACKING_UNSPECIFIED": 0,
+		"DISCOUNT_STACKING_STACKABLE":   1,
+		"DISCOUNT_STACKING_EXCLUSIVE":   2,
+	}
+)
+
+func (x DiscountStacking) Enum() *DiscountStacking {
+	p := new(DiscountStacking)
+	*p = x
+	return p
+}
+
+func (x DiscountStacking) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiscountStacking) Descriptor() protoreflect.EnumDescriptor {
+	return file_oms_discounts_proto_enumTypes[2].Descriptor()
+}
+
+func (DiscountStacking) Type() protoreflect.EnumType {
+	return &file_oms_discounts_proto_enumTypes[2]
+}
+
+func (x DiscountStacking) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiscountStacking.Descriptor instead.
+func (DiscountStacking) EnumDescriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{2}
+}
+
+// DiscountConditions restricts when a rule applies; unset fields do not restrict anything
+type DiscountConditions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartsAt       string `protobuf:"bytes,1,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`                   // RFC 3339; rule applies from this time
+	EndsAt         string `protobuf:"bytes,2,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`                         // RFC 3339; rule applies until this time
+	RecurringStart string `protobuf:"bytes,3,opt,name=recurring_start,json=recurringStart,proto3" json:"recurring_start,omitempty"` // "MM-DD"; first day of a window repeating every year
+	RecurringEnd   string `protobuf:"bytes,4,opt,name=recurring_end,json=recurringEnd,proto3" json:"recurring_end,omitempty"`       // "MM-DD"; last day of the yearly window
+	MinQuantity    int32  `protobuf:"varint,5,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`         // Line rules: minimum line quantity. Order rules: minimum total quantity
+	MinOrderCount  int32  `protobuf:"varint,6,opt,name=min_order_count,json=minOrderCount,proto3" json:"min_order_count,omitempty"` // Minimum number of orders the customer has placed before
+	UserId         int32  `protobuf:"varint,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                        // Only for this customer
+	ItemId         int32  `protobuf:"varint,8,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                        // Line rules: only this item. Order rules: the order must contain it
+}
+
+func (x *DiscountConditions) Reset() {
+	*x = DiscountConditions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_discounts_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscountConditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscountConditions) ProtoMessage() {}
+
+func (x *DiscountConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_discounts_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscountConditions.ProtoReflect.Descriptor instead.
+func (*DiscountConditions) Descriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DiscountConditions) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *DiscountConditions) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *DiscountConditions) GetRecurringStart() string {
+	if x != nil {
+		return x.RecurringStart
+	}
+	return ""
+}
+
+func (x *DiscountConditions) GetRecurringEnd() string {
+	if x != nil {
+		return x.RecurringEnd
+	}
+	return ""
+}
+
+func (x *DiscountConditions) GetMinQuantity() int32 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+func (x *DiscountConditions) GetMinOrderCount() int32 {
+	if x != nil {
+		return x.MinOrderCount
+	}
+	return 0
+}
+
+func (x *DiscountConditions) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DiscountConditions) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+// DiscountRule is a discount definition evaluated when orders are priced
+type DiscountRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind       DiscountKind        `protobuf:"varint,3,opt,name=kind,proto3,enum=DiscountKind" json:"kind,omitempty"`
+	Scope      DiscountScope       `protobuf:"varint,4,opt,name=scope,proto3,enum=DiscountScope" json:"scope,omitempty"`
+	Value      float64             `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`      // Percentage rules: the percentage. Fixed rules: deprecated amount in the default currency
+	Priority   int32               `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"` // Higher priority rules are evaluated first
+	Stacking   DiscountStacking    `protobuf:"varint,7,opt,name=stacking,proto3,enum=DiscountStacking" json:"stacking,omitempty"`
+	Active     bool                `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	Conditions *DiscountConditions `protobuf:"bytes,9,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	CreatedAt  string              `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  string              `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Amount     *Money              `protobuf:"bytes,12,opt,name=amount,proto3" json:"amount,omitempty"` // Fixed rules: the amount off; applies to orders in its currency only
+}
+
+func (x *DiscountRule) Reset() {
+	*x = DiscountRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_discounts_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscountRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscountRule) ProtoMessage() {}
+
+func (x *DiscountRule) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_discounts_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscountRule.ProtoReflect.Descriptor instead.
+func (*DiscountRule) Descriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DiscountRule) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DiscountRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiscountRule) GetKind() DiscountKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DiscountKind_DISCOUNT_KIND_UNSPECIFIED
+}
+
+func (x *DiscountRule) GetScope() DiscountScope {
+	if x != nil {
+		return x.Scope
+	}
+	return DiscountScope_DISCOUNT_SCOPE_UNSPECIFIED
+}
+
+func (x *DiscountRule) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *DiscountRule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *DiscountRule) GetStacking() DiscountStacking {
+	if x != nil {
+		return x.Stacking
+	}
+	return DiscountStacking_DISCOUNT_STACKING_UNSPECIFIED
+}
+
+func (x *DiscountRule) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *DiscountRule) GetConditions() *DiscountConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *DiscountRule) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DiscountRule) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *DiscountRule) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type CreateDiscountRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *DiscountRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *CreateDiscountRuleRequest) Reset() {
+	*x = CreateDiscountRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_discounts_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDiscountRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDiscountRuleRequest) ProtoMessage() {}
+
+func (x *CreateDiscountRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_discounts_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDiscountRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateDiscountRuleRequest) Descriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateDiscountRuleRequest) GetRule() *DiscountRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// UpdateDiscountRuleRequest replaces the rule with the id in rule.id
+type UpdateDiscountRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *DiscountRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *UpdateDiscountRuleRequest) Reset() {
+	*x = UpdateDiscountRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_discounts_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDiscountRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDiscountRuleRequest) ProtoMessage() {}
+
+func (x *UpdateDiscountRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_discounts_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDiscountRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDiscountRuleRequest) Descriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateDiscountRuleRequest) GetRule() *DiscountRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type GetDiscountRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetDiscountRuleRequest) Reset() {
+	*x = GetDiscountRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_discounts_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiscountRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscountRuleRequest) ProtoMessage() {}
+
+func (x *GetDiscountRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_discounts_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscountRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetDiscountRuleRequest) Descriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDiscountRuleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetAllDiscountRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActiveOnly bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+}
+
+func (x *GetAllDiscountRulesRequest) Reset() {
+	*x = GetAllDiscountRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_discounts_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllDiscountRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllDiscountRulesRequest) ProtoMessage() {}
+
+func (x *GetAllDiscountRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_discounts_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllDiscountRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetAllDiscountRulesRequest) Descriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAllDiscountRulesRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type GetAllDiscountRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*DiscountRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"` // Sorted in evaluation order
+}
+
+func (x *GetAllDiscountRulesResponse) Reset() {
+	*x = GetAllDiscountRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_discounts_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllDiscountRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllDiscountRulesResponse) ProtoMessage() {}
+
+func (x *GetAllDiscountRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_discounts_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllDiscountRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetAllDiscountRulesResponse) Descriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAllDiscountRulesResponse) GetRules() []*DiscountRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type DeleteDiscountRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteDiscountRuleRequest) Reset() {
+	*x = DeleteDiscountRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_discounts_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDiscountRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDiscountRuleRequest) ProtoMessage() {}
+
+func (x *DeleteDiscountRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_discounts_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDiscountRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDiscountRuleRequest) Descriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteDiscountRuleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteDiscountRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // Success or error message
+}
+
+func (x *DeleteDiscountRuleResponse) Reset() {
+	*x = DeleteDiscountRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_discounts_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDiscountRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDiscountRuleResponse) ProtoMessage() {}
+
+func (x *DeleteDiscountRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_discounts_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDiscountRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDiscountRuleResponse) Descriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteDiscountRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Coupon is a promo code customers can redeem on an order
+type Coupon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                    int32        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code                  string       `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // Case-insensitive; stored upper case
+	Kind                  DiscountKind `protobuf:"varint,3,opt,name=kind,proto3,enum=DiscountKind" json:"kind,omitempty"`
+	Value                 float64      `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`                                                                 // Percentage coupons: percentage of the eligible subtotal. Fixed coupons: deprecated amount in the default currency
+	StartsAt              string       `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`                                             // RFC 3339; optional
+	EndsAt                string       `protobuf:"bytes,6,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`                                                   // RFC 3339; optional
+	MaxRedemptions        int32        `protobuf:"varint,7,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`                          // Across all customers; 0 for unlimited
+	MaxRedemptionsPerUser int32        `protobuf:"varint,8,opt,name=max_redemptions_per_user,json=maxRedemptionsPerUser,proto3" json:"max_redemptions_per_user,omitempty"` // 0 for unlimited
+	// Deprecated: Do not use.
+	MinOrderValue      float64 `protobuf:"fixed64,9,opt,name=min_order_value,json=minOrderValue,proto3" json:"min_order_value,omitempty"`              // Use min_order_value_money
+	EligibleItemIds    []int32 `protobuf:"varint,10,rep,packed,name=eligible_item_ids,json=eligibleItemIds,proto3" json:"eligible_item_ids,omitempty"` // Empty means every item is eligible
+	Active             bool    `protobuf:"varint,11,opt,name=active,proto3" json:"active,omitempty"`
+	RedemptionCount    int32   `protobuf:"varint,12,opt,name=redemption_count,json=redemptionCount,proto3" json:"redemption_count,omitempty"` // Output only
+	CreatedAt          string  `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount             *Money  `protobuf:"bytes,14,opt,name=amount,proto3" json:"amount,omitempty"`                                                       // Fixed coupons: the amount off; valid for orders in its currency only
+	MinOrderValueMoney *Money  `protobuf:"bytes,15,opt,name=min_order_value_money,json=minOrderValueMoney,proto3" json:"min_order_value_money,omitempty"` // Minimum order subtotal
+}
+
+func (x *Coupon) Reset() {
+	*x = Coupon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_discounts_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Coupon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coupon) ProtoMessage() {}
+
+func (x *Coupon) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_discounts_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coupon.ProtoReflect.Descriptor instead.
+func (*Coupon) Descriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Coupon) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Coupon) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Coupon) GetKind() DiscountKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DiscountKind_DISCOUNT_KIND_UNSPECIFIED
+}
+
+func (x *Coupon) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Coupon) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *Coupon) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *Coupon) GetMaxRedemptions() int32 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *Coupon) GetMaxRedemptionsPerUser() int32 {
+	if x != nil {
+		return x.MaxRedemptionsPerUser
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *Coupon) GetMinOrderValue() float64 {
+	if x != nil {
+		return x.MinOrderValue
+	}
+	return 0
+}
+
+func (x *Coupon) GetEligibleItemIds() []int32 {
+	if x != nil {
+		return x.EligibleItemIds
+	}
+	return nil
+}
+
+func (x *Coupon) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Coupon) GetRedemptionCount() int32 {
+	if x != nil {
+		return x.RedemptionCount
+	}
+	return 0
+}
+
+func (x *Coupon) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Coupon) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Coupon) GetMinOrderValueMoney() *Money {
+	if x != nil {
+		return x.MinOrderValueMoney
+	}
+	return nil
+}
+
+type CreateCouponRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coupon *Coupon `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+}
+
+func (x *CreateCouponRequest) Reset() {
+	*x = CreateCouponRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_discounts_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCouponRequest) ProtoMessage() {}
+
+func (x *CreateCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_discounts_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCouponRequest.ProtoReflect.Descriptor instead.
+func (*CreateCouponRequest) Descriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateCouponRequest) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+type GetCouponRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *GetCouponRequest) Reset() {
+	*x = GetCouponRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_discounts_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCouponRequest) ProtoMessage() {}
+
+func (x *GetCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_discounts_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCouponRequest.ProtoReflect.Descriptor instead.
+func (*GetCouponRequest) Descriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type GetAllCouponsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActiveOnly bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+}
+
+func (x *GetAllCouponsRequest) Reset() {
+	*x = GetAllCouponsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_discounts_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllCouponsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllCouponsRequest) ProtoMessage() {}
+
+func (x *GetAllCouponsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_discounts_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllCouponsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllCouponsRequest) Descriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetAllCouponsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type GetAllCouponsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coupons []*Coupon `protobuf:"bytes,1,rep,name=coupons,proto3" json:"coupons,omitempty"`
+}
+
+func (x *GetAllCouponsResponse) Reset() {
+	*x = GetAllCouponsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_discounts_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllCouponsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllCouponsResponse) ProtoMessage() {}
+
+func (x *GetAllCouponsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_discounts_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllCouponsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllCouponsResponse) Descriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetAllCouponsResponse) GetCoupons() []*Coupon {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
+type DeleteCouponRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *DeleteCouponRequest) Reset() {
+	*x = DeleteCouponRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_discounts_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCouponRequest) ProtoMessage() {}
+
+func (x *DeleteCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_discounts_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCouponRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCouponRequest) Descriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteCouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DeleteCouponResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // Success or error message
+}
+
+func (x *DeleteCouponResponse) Reset() {
+	*x = DeleteCouponResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_discounts_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCouponResponse) ProtoMessage() {}
+
+func (x *DeleteCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_discounts_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCouponResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCouponResponse) Descriptor() ([]byte, []int) {
+	return file_oms_discounts_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteCouponResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_oms_discounts_proto protoreflect.FileDescriptor
+
+var file_oms_discounts_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x6f, 0x6d, 0x73, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x6f, 0x6d, 0x73, 0x5f, 0x6d, 0x6f, 0x6e, 0x65, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x95, 0x02, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e,
+	0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x64,
+	0x73, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65,
+	0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d,
+	0x69, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x87,
+	0x03, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0d, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2d,
+	0x0a, 0x08, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3e, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x3e, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x3d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c,
+	0x79, 0x22, 0x42, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x36, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x92, 0x04, 0x0a, 0x06, 0x43,
+	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x37, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x0f, 0x6d, 0x69,
+	0x6e, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62,
+	0x6c, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x0f, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65,
+	0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x12, 0x6d, 0x69, 0x6e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x22,
+	0x36, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52,
+	0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22,
+	0x37, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x3a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x07, 0x63, 0x6f, 0x75,
+	0x70, 0x6f, 0x6e, 0x73, 0x22, 0x29, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
+	0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22,
+	0x30, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2a, 0x64, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1c, 0x0a, 0x18, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x17,
+	0x0a, 0x13, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x46, 0x49, 0x58, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x62, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x49, 0x53, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x49, 0x53, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53,
+	0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x02, 0x2a, 0x77, 0x0a, 0x10, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12,
+	0x21, 0x0a, 0x1d, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x43,
+	0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x43, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x43, 0x4b, 0x41, 0x42, 0x4c,
+	0x45, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x43, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x58, 0x43, 0x4c, 0x55, 0x53, 0x49,
+	0x56, 0x45, 0x10, 0x02, 0x32, 0xd0, 0x04, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1a,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x64,
+	0x12, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x50, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x1b, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x16, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x42, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0d, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x51, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70,
+	0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x07, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f,
+	0x6e, 0x12, 0x27, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x11,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x07, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x70, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_oms_discounts_proto_rawDescOnce sync.Once
+	file_oms_discounts_proto_rawDescData = file_oms_discounts_proto_rawDesc
+)
+
+func file_oms_discounts_proto_rawDescGZIP() []byte {
+	file_oms_discounts_proto_rawDescOnce.Do(func() {
+		file_oms_discounts_proto_rawDescData = protoimpl.X.CompressGZIP(file_oms_discounts_proto_rawDescData)
+	})
+	return file_oms_discounts_proto_rawDescData
+}
+
+var file_oms_discounts_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_oms_discounts_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_oms_discounts_proto_goTypes = []interface{}{
+	(DiscountKind)(0),                   // 0: DiscountKind
+	(DiscountScope)(0),                  // 1: DiscountScope
+	(DiscountStacking)(0),               // 2: DiscountStacking
+	(*DiscountConditions)(nil),          // 3: DiscountConditions
+	(*DiscountRule)(nil),                // 4: DiscountRule
+	(*CreateDiscountRuleRequest)(nil),   // 5: CreateDiscountRuleRequest
+	(*UpdateDiscountRuleRequest)(nil),   // 6: UpdateDiscountRuleRequest
+	(*GetDiscountRuleRequest)(nil),      // 7: GetDiscountRuleRequest
+	(*GetAllDiscountRulesRequest)(nil),  // 8: GetAllDiscountRulesRequest
+	(*GetAllDiscountRulesResponse)(nil), // 9: GetAllDiscountRulesResponse
+	(*DeleteDiscountRuleRequest)(nil),   // 10: DeleteDiscountRuleRequest
+	(*DeleteDiscountRuleResponse)(nil),  // 11: DeleteDiscountRuleResponse
+	(*Coupon)(nil),                      // 12: Coupon
+	(*CreateCouponRequest)(nil),         // 13: CreateCouponRequest
+	(*GetCouponRequest)(nil),            // 14: GetCouponRequest
+	(*GetAllCouponsRequest)(nil),        // 15: GetAllCouponsRequest
+	(*GetAllCouponsResponse)(nil),       // 16: GetAllCouponsResponse
+	(*DeleteCouponRequest)(nil),         // 17: DeleteCouponRequest
+	(*DeleteCouponResponse)(nil),        // 18: DeleteCouponResponse
+	(*Money)(nil),                       // 19: Money
+}
+var file_oms_discounts_proto_depIdxs = []int32{
+	0,  // 0: DiscountRule.kind:type_name -> DiscountKind
+	1,  // 1: DiscountRule.scope:type_name -> DiscountScope
+	2,  // 2: DiscountRule.stacking:type_name -> DiscountStacking
+	3,  // 3: DiscountRule.conditions:type_name -> DiscountConditions
+	19, // 4: DiscountRule.amount:type_name -> Money
+	4,  // 5: CreateDiscountRuleRequest.rule:type_name -> DiscountRule
+	4,  // 6: UpdateDiscountRuleRequest.rule:type_name -> DiscountRule
+	4,  // 7: GetAllDiscountRulesResponse.rules:type_name -> DiscountRule
+	0,  // 8: Coupon.kind:type_name -> DiscountKind
+	19, // 9: Coupon.amount:type_name -> Money
+	19, // 10: Coupon.min_order_value_money:type_name -> Money
+	12, // 11: CreateCouponRequest.coupon:type_name -> Coupon
+	12, // 12: GetAllCouponsResponse.coupons:type_name -> Coupon
+	5,  // 13: DiscountService.CreateDiscountRule:input_type -> CreateDiscountRuleRequest
+	7,  // 14: DiscountService.GetDiscountRuleById:input_type -> GetDiscountRuleRequest
+	8,  // 15: DiscountService.GetAllDiscountRules:input_type -> GetAllDiscountRulesRequest
+	6,  // 16: DiscountService.UpdateDiscountRuleById:input_type -> UpdateDiscountRuleRequest
+	10, // 17: DiscountService.DeleteDiscountRuleById:input_type -> DeleteDiscountRuleRequest
+	13, // 18: DiscountService.CreateCoupon:input_type -> CreateCouponRequest
+	14, // 19: DiscountService.GetCoupon:input_type -> GetCouponRequest
+	15, // 20: DiscountService.GetAllCoupons:input_type -> GetAllCouponsRequest
+	17, // 21: DiscountService.DeleteCoupon:input_type -> DeleteCouponRequest
+	4,  // 22: DiscountService.CreateDiscountRule:output_type -> DiscountRule
+	4,  // 23: DiscountService.GetDiscountRuleById:output_type -> DiscountRule
+	9,  // 24: DiscountService.GetAllDiscountRules:output_type -> GetAllDiscountRulesResponse
+	4,  // 25: DiscountService.UpdateDiscountRuleById:output_type -> DiscountRule
+	11, // 26: DiscountService.DeleteDiscountRuleById:output_type -> DeleteDiscountRuleResponse
+	12, // 27: DiscountService.CreateCoupon:output_type -> Coupon
+	12, // 28: DiscountService.GetCoupon:output_type -> Coupon
+	16, // 29: DiscountService.GetAllCoupons:output_type -> GetAllCouponsResponse
+	18, // 30: DiscountService.DeleteCoupon:output_type -> DeleteCouponResponse
+	22, // [22:31] is the sub-list for method output_type
+	13, // [13:22] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_oms_discounts_proto_init() }
+func file_oms_discounts_proto_init() {
+	if File_oms_discounts_proto != nil {
+		return
+	}
+	file_oms_money_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_oms_discounts_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscountConditions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_discounts_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscountRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_discounts_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDiscountRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_discounts_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDiscountRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_discounts_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiscountRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_discounts_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllDiscountRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_discounts_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllDiscountRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_discounts_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDiscountRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_discounts_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDiscountRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_discounts_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Coupon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_discounts_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCouponRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_discounts_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCouponRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_discounts_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllCouponsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_discounts_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllCouponsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_discounts_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCouponRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_discounts_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCouponResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_oms_discounts_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_oms_discounts_proto_goTypes,
+		DependencyIndexes: file_oms_discounts_proto_depIdxs,
+		EnumInfos:         file_oms_discounts_proto_enumTypes,
+		MessageInfos:      file_oms_discounts_proto_msgTypes,
+	}.Build()
+	File_oms_discounts_proto = out.File
+	file_oms_discounts_proto_rawDesc = nil
+	file_oms_discounts_proto_goTypes = nil
+	file_oms_discounts_proto_depIdxs = nil
+}
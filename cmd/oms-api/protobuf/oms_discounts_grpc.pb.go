@@ -0,0 +1,405 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: oms_discounts.proto
+
+package protobuf
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	DiscountService_CreateDiscountRule_FullMethodName     = "/DiscountService/CreateDiscountRule"
+	DiscountService_GetDiscountRuleById_FullMethodName    = "/DiscountService/GetDiscountRuleById"
+	DiscountService_GetAllDiscountRules_FullMethodName    = "/DiscountService/GetAllDiscountRules"
+	DiscountService_UpdateDiscountRuleById_FullMethodName = "/DiscountService/UpdateDiscountRuleById"
+	DiscountService_DeleteDiscountRuleById_FullMethodName = "/DiscountService/DeleteDiscountRuleById"
+	DiscountService_CreateCoupon_FullMethodName           = "/DiscountService/CreateCoupon"
+	DiscountService_GetCoupon_FullMethodName              = "/DiscountService/GetCoupon"
+	DiscountService_GetAllCoupons_FullMethodName          = "/DiscountService/GetAllCoupons"
+	DiscountService_DeleteCoupon_FullMethodName           = "/DiscountService/DeleteCoupon"
+)
+
+// DiscountServiceClient is the client API for DiscountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DiscountServiceClient interface {
+	CreateDiscountRule(ctx context.Context, in *CreateDiscountRuleRequest, opts ...grpc.CallOption) (*DiscountRule, error)
+	GetDiscountRuleById(ctx context.Context, in *GetDiscountRuleRequest, opts ...grpc.CallOption) (*DiscountRule, error)
+	GetAllDiscountRules(ctx context.Context, in *GetAllDiscountRulesRequest, opts ...grpc.CallOption) (*GetAllDiscountRulesResponse, error)
+	UpdateDiscountRuleById(ctx context.Context, in *UpdateDiscountRuleRequest, opts ...grpc.CallOption) (*DiscountRule, error)
+	DeleteDiscountRuleById(ctx context.Context, in *DeleteDiscountRuleRequest, opts ...grpc.CallOption) (*DeleteDiscountRuleResponse, error)
+	CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...grpc.CallOption) (*Coupon, error)
+	GetCoupon(ctx context.Context, in *GetCouponRequest, opts ...grpc.CallOption) (*Coupon, error)
+	GetAllCoupons(ctx context.Context, in *GetAllCouponsRequest, opts ...grpc.CallOption) (*GetAllCouponsResponse, error)
+	DeleteCoupon(ctx context.Context, in *DeleteCouponRequest, opts ...grpc.CallOption) (*DeleteCouponResponse, error)
+}
+
+type discountServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDiscountServiceClient(cc grpc.ClientConnInterface) DiscountServiceClient {
+	return &discountServiceClient{cc}
+}
+
+func (c *discountServiceClient) CreateDiscountRule(ctx context.Context, in *CreateDiscountRuleRequest, opts ...grpc.CallOption) (*DiscountRule, error) {
+	out := new(DiscountRule)
+	err := c.cc.Invoke(ctx, DiscountService_CreateDiscountRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) GetDiscountRuleById(ctx context.Context, in *GetDiscountRuleRequest, opts ...grpc.CallOption) (*DiscountRule, error) {
+	out := new(DiscountRule)
+	err := c.cc.Invoke(ctx, DiscountService_GetDiscountRuleById_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) GetAllDiscountRules(ctx context.Context, in *GetAllDiscountRulesRequest, opts ...grpc.CallOption) (*GetAllDiscountRulesResponse, error) {
+	out := new(GetAllDiscountRulesResponse)
+	err := c.cc.Invoke(ctx, DiscountService_GetAllDiscountRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) UpdateDiscountRuleById(ctx context.Context, in *UpdateDiscountRuleRequest, opts ...grpc.CallOption) (*DiscountRule, error) {
+	out := new(DiscountRule)
+	err := c.cc.Invoke(ctx, DiscountService_UpdateDiscountRuleById_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) DeleteDiscountRuleById(ctx context.Context, in *DeleteDiscountRuleRequest, opts ...grpc.CallOption) (*DeleteDiscountRuleResponse, error) {
+	out := new(DeleteDiscountRuleResponse)
+	err := c.cc.Invoke(ctx, DiscountService_DeleteDiscountRuleById_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...grpc.CallOption) (*Coupon, error) {
+	out := new(Coupon)
+	err := c.cc.Invoke(ctx, DiscountService_CreateCoupon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) GetCoupon(ctx context.Context, in *GetCouponRequest, opts ...grpc.CallOption) (*Coupon, error) {
+	out := new(Coupon)
+	err := c.cc.Invoke(ctx, DiscountService_GetCoupon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) GetAllCoupons(ctx context.Context, in *GetAllCouponsRequest, opts ...grpc.CallOption) (*GetAllCouponsResponse, error) {
+	out := new(GetAllCouponsResponse)
+	err := c.cc.Invoke(ctx, DiscountService_GetAllCoupons_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) DeleteCoupon(ctx context.Context, in *DeleteCouponRequest, opts ...grpc.CallOption) (*DeleteCouponResponse, error) {
+	out := new(DeleteCouponResponse)
+	err := c.cc.Invoke(ctx, DiscountService_DeleteCoupon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DiscountServiceServer is the server API for DiscountService service.
+// All implementations must embed UnimplementedDiscountServiceServer
+// for forward compatibility
+type DiscountServiceServer interface {
+	CreateDiscountRule(context.Context, *CreateDiscountRuleRequest) (*DiscountRule, error)
+	GetDiscountRuleById(context.Context, *GetDiscountRuleRequest) (*DiscountRule, error)
+	GetAllDiscountRules(context.Context, *GetAllDiscountRulesRequest) (*GetAllDiscountRulesResponse, error)
+	UpdateDiscountRuleById(context.Context, *UpdateDiscountRuleRequest) (*DiscountRule, error)
+	DeleteDiscountRuleById(context.Context, *DeleteDiscountRuleRequest) (*DeleteDiscountRuleResponse, error)
+	CreateCoupon(context.Context, *CreateCouponRequest) (*Coupon, error)
+	GetCoupon(context.Context, *GetCouponRequest) (*Coupon, error)
+	GetAllCoupons(context.Context, *GetAllCouponsRequest) (*GetAllCouponsResponse, error)
+	DeleteCoupon(context.Context, *DeleteCouponRequest) (*DeleteCouponResponse, error)
+	mustEmbedUnimplementedDiscountServiceServer()
+}
+
+// UnimplementedDiscountServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDiscountServiceServer struct {
+}
+
+func (UnimplementedDiscountServiceServer) CreateDiscountRule(context.Context, *CreateDiscountRuleRequest) (*DiscountRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDiscountRule not implemented")
+}
+func (UnimplementedDiscountServiceServer) GetDiscountRuleById(context.Context, *GetDiscountRuleRequest) (*DiscountRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscountRuleById not implemented")
+}
+func (UnimplementedDiscountServiceServer) GetAllDiscountRules(context.Context, *GetAllDiscountRulesRequest) (*GetAllDiscountRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllDiscountRules not implemented")
+}
+func (UnimplementedDiscountServiceServer) UpdateDiscountRuleById(context.Context, *UpdateDiscountRuleRequest) (*DiscountRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDiscountRuleById not implemented")
+}
+func (UnimplementedDiscountServiceServer) DeleteDiscountRuleById(context.Context, *DeleteDiscountRuleRequest) (*DeleteDiscountRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDiscountRuleById not implemented")
+}
+func (UnimplementedDiscountServiceServer) CreateCoupon(context.Context, *CreateCouponRequest) (*Coupon, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCoupon not implemented")
+}
+func (UnimplementedDiscountServiceServer) GetCoupon(context.Context, *GetCouponRequest) (*Coupon, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoupon not implemented")
+}
+func (UnimplementedDiscountServiceServer) GetAllCoupons(context.Context, *GetAllCouponsRequest) (*GetAllCouponsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCoupons not implemented")
+}
+func (UnimplementedDiscountServiceServer) DeleteCoupon(context.Context, *DeleteCouponRequest) (*DeleteCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCoupon not implemented")
+}
+func (UnimplementedDiscountServiceServer) mustEmbedUnimplementedDiscountServiceServer() {}
+
+// UnsafeDiscountServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DiscountServiceServer will
+// result in compilation errors.
+type UnsafeDiscountServiceServer interface {
+	mustEmbedUnimplementedDiscountServiceServer()
+}
+
+func RegisterDiscountServiceServer(s grpc.ServiceRegistrar, srv DiscountServiceServer) {
+	s.RegisterService(&DiscountService_ServiceDesc, srv)
+}
+
+func _DiscountService_CreateDiscountRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDiscountRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).CreateDiscountRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_CreateDiscountRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).CreateDiscountRule(ctx, req.(*CreateDiscountRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_GetDiscountRuleById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiscountRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).GetDiscountRuleById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_GetDiscountRuleById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).GetDiscountRuleById(ctx, req.(*GetDiscountRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_GetAllDiscountRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllDiscountRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).GetAllDiscountRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_GetAllDiscountRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).GetAllDiscountRules(ctx, req.(*GetAllDiscountRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_UpdateDiscountRuleById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDiscountRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).UpdateDiscountRuleById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_UpdateDiscountRuleById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).UpdateDiscountRuleById(ctx, req.(*UpdateDiscountRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_DeleteDiscountRuleById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDiscountRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).DeleteDiscountRuleById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_DeleteDiscountRuleById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).DeleteDiscountRuleById(ctx, req.(*DeleteDiscountRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_CreateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).CreateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_CreateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).CreateCoupon(ctx, req.(*CreateCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_GetCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).GetCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_GetCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).GetCoupon(ctx, req.(*GetCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_GetAllCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).GetAllCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_GetAllCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).GetAllCoupons(ctx, req.(*GetAllCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_DeleteCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).DeleteCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_DeleteCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).DeleteCoupon(ctx, req.(*DeleteCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DiscountService_ServiceDesc is the grpc.ServiceDesc for DiscountService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DiscountService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "DiscountService",
+	HandlerType: (*DiscountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDiscountRule",
+			Handler:    _DiscountService_CreateDiscountRule_Handler,
+		},
+		{
+			MethodName: "GetDiscountRuleById",
+			Handler:    _DiscountService_GetDiscountRuleById_Handler,
+		},
+		{
+			MethodName: "GetAllDiscountRules",
+			Handler:    _DiscountService_GetAllDiscountRules_Handler,
+		},
+		{
+			MethodName: "UpdateDiscountRuleById",
+			Handler:    _DiscountService_UpdateDiscountRuleById_Handler,
+		},
+		{
+			MethodName: "DeleteDiscountRuleById",
+			Handler:    _DiscountService_DeleteDiscountRuleById_Handler,
+		},
+		{
+			MethodName: "CreateCoupon",
+			Handler:    _DiscountService_CreateCoupon_Handler,
+		},
+		{
+			MethodName: "GetCoupon",
+			Handler:    _DiscountService_GetCoupon_Handler,
+		},
+		{
+			MethodName: "GetAllCoupons",
+			Handler:    _DiscountService_GetAllCoupons_Handler,
+		},
+		{
+			MethodName: "DeleteCoupon",
+			Handler:    _DiscountService_DeleteCoupon_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "oms_discounts.proto",
+}
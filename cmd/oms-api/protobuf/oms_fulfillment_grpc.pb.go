@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: oms_fulfillment.proto
+
+package protobuf
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	FulfillmentService_CreateShipment_FullMethodName        = "/FulfillmentService/CreateShipment"
+	FulfillmentService_MarkShipmentDelivered_FullMethodName = "/FulfillmentService/MarkShipmentDelivered"
+	FulfillmentService_GetShipment_FullMethodName           = "/FulfillmentService/GetShipment"
+	FulfillmentService_GetShipmentsByOrderId_FullMethodName = "/FulfillmentService/GetShipmentsByOrderId"
+)
+
+// FulfillmentServiceClient is the client API for FulfillmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FulfillmentServiceClient interface {
+	CreateShipment(ctx context.Context, in *CreateShipmentRequest, opts ...grpc.CallOption) (*Shipment, error)
+	MarkShipmentDelivered(ctx context.Context, in *MarkShipmentDeliveredRequest, opts ...grpc.CallOption) (*Shipment, error)
+	GetShipment(ctx context.Context, in *GetShipmentRequest, opts ...grpc.CallOption) (*Shipment, error)
+	GetShipmentsByOrderId(ctx context.Context, in *GetShipmentsByOrderIdRequest, opts ...grpc.CallOption) (*GetShipmentsResponse, error)
+}
+
+type fulfillmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFulfillmentServiceClient(cc grpc.ClientConnInterface) FulfillmentServiceClient {
+	return &fulfillmentServiceClient{cc}
+}
+
+func (c *fulfillmentServiceClient) CreateShipment(ctx context.Context, in *CreateShipmentRequest, opts ...grpc.CallOption) (*Shipment, error) {
+	out := new(Shipment)
+	err := c.cc.Invoke(ctx, FulfillmentService_CreateShipment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fulfillmentServiceClient) MarkShipmentDelivered(ctx context.Context, in *MarkShipmentDeliveredRequest, opts ...grpc.CallOption) (*Shipment, error) {
+	out := new(Shipment)
+	err := c.cc.Invoke(ctx, FulfillmentService_MarkShipmentDelivered_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fulfillmentServiceClient) GetShipment(ctx context.Context, in *GetShipmentRequest, opts ...grpc.CallOption) (*Shipment, error) {
+	out := new(Shipment)
+	err := c.cc.Invoke(ctx, FulfillmentService_GetShipment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fulfillmentServiceClient) GetShipmentsByOrderId(ctx context.Context, in *GetShipmentsByOrderIdRequest, opts ...grpc.CallOption) (*GetShipmentsResponse, error) {
+	out := new(GetShipmentsResponse)
+	err := c.cc.Invoke(ctx, FulfillmentService_GetShipmentsByOrderId_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FulfillmentServiceServer is the server API for FulfillmentService service.
+// All implementations must embed UnimplementedFulfillmentServiceServer
+// for forward compatibility
+type FulfillmentServiceServer interface {
+	CreateShipment(context.Context, *CreateShipmentRequest) (*Shipment, error)
+	MarkShipmentDelivered(context.Context, *MarkShipmentDeliveredRequest) (*Shipment, error)
+	GetShipment(context.Context, *GetShipmentRequest) (*Shipment, error)
+	GetShipmentsByOrderId(context.Context, *GetShipmentsByOrderIdRequest) (*GetShipmentsResponse, error)
+	mustEmbedUnimplementedFulfillmentServiceServer()
+}
+
+// UnimplementedFulfillmentServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFulfillmentServiceServer struct {
+}
+
+func (UnimplementedFulfillmentServiceServer) CreateShipment(context.Context, *CreateShipmentRequest) (*Shipment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShipment not implemented")
+}
+func (UnimplementedFulfillmentServiceServer) MarkShipmentDelivered(context.Context, *MarkShipmentDeliveredRequest) (*Shipment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkShipmentDelivered not implemented")
+}
+func (UnimplementedFulfillmentServiceServer) GetShipment(context.Context, *GetShipmentRequest) (*Shipment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShipment not implemented")
+}
+func (UnimplementedFulfillmentServiceServer) GetShipmentsByOrderId(context.Context, *GetShipmentsByOrderIdRequest) (*GetShipmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShipmentsByOrderId not implemented")
+}
+func (UnimplementedFulfillmentServiceServer) mustEmbedUnimplementedFulfillmentServiceServer() {}
+
+// UnsafeFulfillmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FulfillmentServiceServer will
+// result in compilation errors.
+type UnsafeFulfillmentServiceServer interface {
+	mustEmbedUnimplementedFulfillmentServiceServer()
+}
+
+func RegisterFulfillmentServiceServer(s grpc.ServiceRegistrar, srv FulfillmentServiceServer) {
+	s.RegisterService(&FulfillmentService_ServiceDesc, srv)
+}
+
+func _FulfillmentService_CreateShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FulfillmentServiceServer).CreateShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FulfillmentService_CreateShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FulfillmentServiceServer).CreateShipment(ctx, req.(*CreateShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FulfillmentService_MarkShipmentDelivered_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkShipmentDeliveredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FulfillmentServiceServer).MarkShipmentDelivered(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FulfillmentService_MarkShipmentDelivered_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FulfillmentServiceServer).MarkShipmentDelivered(ctx, req.(*MarkShipmentDeliveredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FulfillmentService_GetShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FulfillmentServiceServer).GetShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FulfillmentService_GetShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FulfillmentServiceServer).GetShipment(ctx, req.(*GetShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FulfillmentService_GetShipmentsByOrderId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShipmentsByOrderIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FulfillmentServiceServer).GetShipmentsByOrderId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FulfillmentService_GetShipmentsByOrderId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FulfillmentServiceServer).GetShipmentsByOrderId(ctx, req.(*GetShipmentsByOrderIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FulfillmentService_ServiceDesc is the grpc.ServiceDesc for FulfillmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FulfillmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "FulfillmentService",
+	HandlerType: (*FulfillmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateShipment",
+			Handler:    _FulfillmentService_CreateShipment_Handler,
+		},
+		{
+			MethodName: "MarkShipmentDelivered",
+			Handler:    _FulfillmentService_MarkShipmentDelivered_Handler,
+		},
+		{
+			MethodName: "GetShipment",
+			Handler:    _FulfillmentService_GetShipment_Handler,
+		},
+		{
+			MethodName: "GetShipmentsByOrderId",
+			Handler:    _FulfillmentService_GetShipmentsByOrderId_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "oms_fulfillment.proto",
+}
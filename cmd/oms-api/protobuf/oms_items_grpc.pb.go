@@ -19,11 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	OmsItemService_CreateItem_FullMethodName     = "/omsItemService/CreateItem"
-	OmsItemService_GetItemById_FullMethodName    = "/omsItemService/GetItemById"
-	OmsItemService_GetAllItems_FullMethodName    = "/omsItemService/GetAllItems"
-	OmsItemService_UpdateItemById_FullMethodName = "/omsItemService/UpdateItemById"
-	OmsItemService_DeleteItemById_FullMethodName = "/omsItemService/DeleteItemById"
+	OmsItemService_CreateItem_FullMethodName      = "/omsItemService/CreateItem"
+	OmsItemService_GetItemById_FullMethodName     = "/omsItemService/GetItemById"
+	OmsItemService_GetAllItems_FullMethodName     = "/omsItemService/GetAllItems"
+	OmsItemService_UpdateItemById_FullMethodName  = "/omsItemService/UpdateItemById"
+	OmsItemService_DeleteItemById_FullMethodName  = "/omsItemService/DeleteItemById"
+	OmsItemService_AdjustStock_FullMethodName     = "/omsItemService/AdjustStock"
+	OmsItemService_GetStock_FullMethodName        = "/omsItemService/GetStock"
+	OmsItemService_SetItemPrice_FullMethodName    = "/omsItemService/SetItemPrice"
+	OmsItemService_DeleteItemPrice_FullMethodName = "/omsItemService/DeleteItemPrice"
+	OmsItemService_StreamItems_FullMethodName     = "/omsItemService/StreamItems"
 )
 
 // OmsItemServiceClient is the client API for OmsItemService service.
@@ -32,9 +37,15 @@ const (
 type OmsItemServiceClient interface {
 	CreateItem(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*ItemResponse, error)
 	GetItemById(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*ItemResponse, error)
-	GetAllItems(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAllItemResponse, error)
+	GetAllItems(ctx context.Context, in *GetAllItemsRequest, opts ...grpc.CallOption) (*GetAllItemResponse, error)
 	UpdateItemById(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*ItemResponse, error)
 	DeleteItemById(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*DeleteItemResponse, error)
+	AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*StockLevel, error)
+	GetStock(ctx context.Context, in *GetStockRequest, opts ...grpc.CallOption) (*StockLevel, error)
+	SetItemPrice(ctx context.Context, in *SetItemPriceRequest, opts ...grpc.CallOption) (*ItemResponse, error)
+	DeleteItemPrice(ctx context.Context, in *DeleteItemPriceRequest, opts ...grpc.CallOption) (*ItemResponse, error)
+	// StreamItems sends every matching item; page_size sets the database batch size
+	StreamItems(ctx context.Context, in *GetAllItemsRequest, opts ...grpc.CallOption) (OmsItemService_StreamItemsClient, error)
 }
 
 type omsItemServiceClient struct {
@@ -63,7 +74,7 @@ func (c *omsItemServiceClient) GetItemById(ctx context.Context, in *GetItemReque
 	return out, nil
 }
 
-func (c *omsItemServiceClient) GetAllItems(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAllItemResponse, error) {
+func (c *omsItemServiceClient) GetAllItems(ctx context.Context, in *GetAllItemsRequest, opts ...grpc.CallOption) (*GetAllItemResponse, error) {
 	out := new(GetAllItemResponse)
 	err := c.cc.Invoke(ctx, OmsItemService_GetAllItems_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -90,15 +101,89 @@ func (c *omsItemServiceClient) DeleteItemById(ctx context.Context, in *DeleteIte
 	return out, nil
 }
 
+func (c *omsItemServiceClient) AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*StockLevel, error) {
+	out := new(StockLevel)
+	err := c.cc.Invoke(ctx, OmsItemService_AdjustStock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *omsItemServiceClient) GetStock(ctx context.Context, in *GetStockRequest, opts ...grpc.CallOption) (*StockLevel, error) {
+	out := new(StockLevel)
+	err := c.cc.Invoke(ctx, OmsItemService_GetStock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *omsItemServiceClient) SetItemPrice(ctx context.Context, in *SetItemPriceRequest, opts ...grpc.CallOption) (*ItemResponse, error) {
+	out := new(ItemResponse)
+	err := c.cc.Invoke(ctx, OmsItemService_SetItemPrice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *omsItemServiceClient) DeleteItemPrice(ctx context.Context, in *DeleteItemPriceRequest, opts ...grpc.CallOption) (*ItemResponse, error) {
+	out := new(ItemResponse)
+	err := c.cc.Invoke(ctx, OmsItemService_DeleteItemPrice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *omsItemServiceClient) StreamItems(ctx context.Context, in *GetAllItemsRequest, opts ...grpc.CallOption) (OmsItemService_StreamItemsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OmsItemService_ServiceDesc.Streams[0], OmsItemService_StreamItems_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &omsItemServiceStreamItemsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OmsItemService_StreamItemsClient interface {
+	Recv() (*ItemResponse, error)
+	grpc.ClientStream
+}
+
+type omsItemServiceStreamItemsClient struct {
+	grpc.ClientStream
+}
+
+func (x *omsItemServiceStreamItemsClient) Recv() (*ItemResponse, error) {
+	m := new(ItemResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // OmsItemServiceServer is the server API for OmsItemService service.
 // All implementations must embed UnimplementedOmsItemServiceServer
 // for forward compatibility
 type OmsItemServiceServer interface {
 	CreateItem(context.Context, *ItemRequest) (*ItemResponse, error)
 	GetItemById(context.Context, *GetItemRequest) (*ItemResponse, error)
-	GetAllItems(context.Context, *EmptyRequest) (*GetAllItemResponse, error)
+	GetAllItems(context.Context, *GetAllItemsRequest) (*GetAllItemResponse, error)
 	UpdateItemById(context.Context, *UpdateItemRequest) (*ItemResponse, error)
 	DeleteItemById(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error)
+	AdjustStock(context.Context, *AdjustStockRequest) (*StockLevel, error)
+	GetStock(context.Context, *GetStockRequest) (*StockLevel, error)
+	SetItemPrice(context.Context, *SetItemPriceRequest) (*ItemResponse, error)
+	DeleteItemPrice(context.Context, *DeleteItemPriceRequest) (*ItemResponse, error)
+	// StreamItems sends every matching item; page_size sets the database batch size
+	StreamItems(*GetAllItemsRequest, OmsItemService_StreamItemsServer) error
 	mustEmbedUnimplementedOmsItemServiceServer()
 }
 
@@ -112,7 +197,7 @@ func (UnimplementedOmsItemServiceServer) CreateItem(context.Context, *ItemReques
 func (UnimplementedOmsItemServiceServer) GetItemById(context.Context, *GetItemRequest) (*ItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetItemById not implemented")
 }
-func (UnimplementedOmsItemServiceServer) GetAllItems(context.Context, *EmptyRequest) (*GetAllItemResponse, error) {
+func (UnimplementedOmsItemServiceServer) GetAllItems(context.Context, *GetAllItemsRequest) (*GetAllItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllItems not implemented")
 }
 func (UnimplementedOmsItemServiceServer) UpdateItemById(context.Context, *UpdateItemRequest) (*ItemResponse, error) {
@@ -121,6 +206,21 @@ func (UnimplementedOmsItemServiceServer) UpdateItemById(context.Context, *Update
 func (UnimplementedOmsItemServiceServer) DeleteItemById(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteItemById not implemented")
 }
+func (UnimplementedOmsItemServiceServer) AdjustStock(context.Context, *AdjustStockRequest) (*StockLevel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustStock not implemented")
+}
+func (UnimplementedOmsItemServiceServer) GetStock(context.Context, *GetStockRequest) (*StockLevel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStock not implemented")
+}
+func (UnimplementedOmsItemServiceServer) SetItemPrice(context.Context, *SetItemPriceRequest) (*ItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetItemPrice not implemented")
+}
+func (UnimplementedOmsItemServiceServer) DeleteItemPrice(context.Context, *DeleteItemPriceRequest) (*ItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteItemPrice not implemented")
+}
+func (UnimplementedOmsItemServiceServer) StreamItems(*GetAllItemsRequest, OmsItemService_StreamItemsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamItems not implemented")
+}
 func (UnimplementedOmsItemServiceServer) mustEmbedUnimplementedOmsItemServiceServer() {}
 
 // UnsafeOmsItemServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -171,7 +271,7 @@ func _OmsItemService_GetItemById_Handler(srv interface{}, ctx context.Context, d
 }
 
 func _OmsItemService_GetAllItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetAllItemsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -183,7 +283,7 @@ func _OmsItemService_GetAllItems_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: OmsItemService_GetAllItems_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OmsItemServiceServer).GetAllItems(ctx, req.(*EmptyRequest))
+		return srv.(OmsItemServiceServer).GetAllItems(ctx, req.(*GetAllItemsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -224,6 +324,99 @@ func _OmsItemService_DeleteItemById_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OmsItemService_AdjustStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OmsItemServiceServer).AdjustStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OmsItemService_AdjustStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OmsItemServiceServer).AdjustStock(ctx, req.(*AdjustStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OmsItemService_GetStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OmsItemServiceServer).GetStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OmsItemService_GetStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OmsItemServiceServer).GetStock(ctx, req.(*GetStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OmsItemService_SetItemPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetItemPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OmsItemServiceServer).SetItemPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OmsItemService_SetItemPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OmsItemServiceServer).SetItemPrice(ctx, req.(*SetItemPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OmsItemService_DeleteItemPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItemPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OmsItemServiceServer).DeleteItemPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OmsItemService_DeleteItemPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OmsItemServiceServer).DeleteItemPrice(ctx, req.(*DeleteItemPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OmsItemService_StreamItems_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAllItemsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OmsItemServiceServer).StreamItems(m, &omsItemServiceStreamItemsServer{stream})
+}
+
+type OmsItemService_StreamItemsServer interface {
+	Send(*ItemResponse) error
+	grpc.ServerStream
+}
+
+type omsItemServiceStreamItemsServer struct {
+	grpc.ServerStream
+}
+
+func (x *omsItemServiceStreamItemsServer) Send(m *ItemResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // OmsItemService_ServiceDesc is the grpc.ServiceDesc for OmsItemService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -251,7 +444,29 @@ var OmsItemService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteItemById",
 			Handler:    _OmsItemService_DeleteItemById_Handler,
 		},
+		{
+			MethodName: "AdjustStock",
+			Handler:    _OmsItemService_AdjustStock_Handler,
+		},
+		{
+			MethodName: "GetStock",
+			Handler:    _OmsItemService_GetStock_Handler,
+		},
+		{
+			MethodName: "SetItemPrice",
+			Handler:    _OmsItemService_SetItemPrice_Handler,
+		},
+		{
+			MethodName: "DeleteItemPrice",
+			Handler:    _OmsItemService_DeleteItemPrice_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamItems",
+			Handler:       _OmsItemService_StreamItems_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "oms_items.proto",
 }
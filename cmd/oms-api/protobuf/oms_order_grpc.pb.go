@@ -25,6 +25,11 @@ const (
 	OrderService_GetOrderById_FullMethodName               = "/OrderService/GetOrderById"
 	OrderService_GetAllOrders_FullMethodName               = "/OrderService/GetAllOrders"
 	OrderService_UpdateOrderStatusByOrderId_FullMethodName = "/OrderService/UpdateOrderStatusByOrderId"
+	OrderService_TransitionOrder_FullMethodName            = "/OrderService/TransitionOrder"
+	OrderService_QuoteOrder_FullMethodName                 = "/OrderService/QuoteOrder"
+	OrderService_Checkout_FullMethodName                   = "/OrderService/Checkout"
+	OrderService_GetCheckout_FullMethodName                = "/OrderService/GetCheckout"
+	OrderService_StreamOrders_FullMethodName               = "/OrderService/StreamOrders"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -32,11 +37,26 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OrderServiceClient interface {
 	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
+	// UpdateOrderById replaces the lines of an order. They are priced at the exchange rates the order was
+	// priced with; only currencies it did not use before take the current rate.
 	UpdateOrderById(ctx context.Context, in *UpdateOrderRequest, opts ...grpc.CallOption) (*OrderResponse1, error)
+	// DeleteOrderById cancels an order, as TransitionOrder does, and deletes it
 	DeleteOrderById(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*DeleteOrderResponse, error)
 	GetOrderById(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
 	GetAllOrders(ctx context.Context, in *GetAllOrdersRequest, opts ...grpc.CallOption) (*AllOrderReponse, error)
 	UpdateOrderStatusByOrderId(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*UpdateOrderStatusResponse, error)
+	// TransitionOrder moves an order to another status. Cancelling voids its authorized payments and refunds
+	// its captured ones first; when the provider does not, the order is not cancelled. Partially shipped,
+	// shipped, delivered and returned are refused: recording shipments and returns moves orders there.
+	TransitionOrder(ctx context.Context, in *TransitionOrderRequest, opts ...grpc.CallOption) (*TransitionOrderResponse, error)
+	QuoteOrder(ctx context.Context, in *QuoteOrderRequest, opts ...grpc.CallOption) (*QuoteOrderResponse, error)
+	// Checkout confirms an order through a saga that resumes after a restart; a failed checkout is
+	// returned with status CHECKOUT_STATUS_FAILED rather than as an error
+	Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*CheckoutResponse, error)
+	// GetCheckout returns the latest checkout of an order
+	GetCheckout(ctx context.Context, in *GetCheckoutRequest, opts ...grpc.CallOption) (*CheckoutSaga, error)
+	// StreamOrders sends every matching order with its items; page_size sets the database batch size
+	StreamOrders(ctx context.Context, in *GetAllOrdersRequest, opts ...grpc.CallOption) (OrderService_StreamOrdersClient, error)
 }
 
 type orderServiceClient struct {
@@ -101,16 +121,99 @@ func (c *orderServiceClient) UpdateOrderStatusByOrderId(ctx context.Context, in
 	return out, nil
 }
 
+func (c *orderServiceClient) TransitionOrder(ctx context.Context, in *TransitionOrderRequest, opts ...grpc.CallOption) (*TransitionOrderResponse, error) {
+	out := new(TransitionOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_TransitionOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) QuoteOrder(ctx context.Context, in *QuoteOrderRequest, opts ...grpc.CallOption) (*QuoteOrderResponse, error) {
+	out := new(QuoteOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_QuoteOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*CheckoutResponse, error) {
+	out := new(CheckoutResponse)
+	err := c.cc.Invoke(ctx, OrderService_Checkout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetCheckout(ctx context.Context, in *GetCheckoutRequest, opts ...grpc.CallOption) (*CheckoutSaga, error) {
+	out := new(CheckoutSaga)
+	err := c.cc.Invoke(ctx, OrderService_GetCheckout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) StreamOrders(ctx context.Context, in *GetAllOrdersRequest, opts ...grpc.CallOption) (OrderService_StreamOrdersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OrderService_ServiceDesc.Streams[0], OrderService_StreamOrders_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orderServiceStreamOrdersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OrderService_StreamOrdersClient interface {
+	Recv() (*OrderResponse1, error)
+	grpc.ClientStream
+}
+
+type orderServiceStreamOrdersClient struct {
+	grpc.ClientStream
+}
+
+func (x *orderServiceStreamOrdersClient) Recv() (*OrderResponse1, error) {
+	m := new(OrderResponse1)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility
 type OrderServiceServer interface {
 	CreateOrder(context.Context, *CreateOrderRequest) (*OrderResponse, error)
+	// UpdateOrderById replaces the lines of an order. They are priced at the exchange rates the order was
+	// priced with; only currencies it did not use before take the current rate.
 	UpdateOrderById(context.Context, *UpdateOrderRequest) (*OrderResponse1, error)
+	// DeleteOrderById cancels an order, as TransitionOrder does, and deletes it
 	DeleteOrderById(context.Context, *DeleteOrderRequest) (*DeleteOrderResponse, error)
 	GetOrderById(context.Context, *GetOrderRequest) (*OrderResponse, error)
 	GetAllOrders(context.Context, *GetAllOrdersRequest) (*AllOrderReponse, error)
 	UpdateOrderStatusByOrderId(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error)
+	// TransitionOrder moves an order to another status. Cancelling voids its authorized payments and refunds
+	// its captured ones first; when the provider does not, the order is not cancelled. Partially shipped,
+	// shipped, delivered and returned are refused: recording shipments and returns moves orders there.
+	TransitionOrder(context.Context, *TransitionOrderRequest) (*TransitionOrderResponse, error)
+	QuoteOrder(context.Context, *QuoteOrderRequest) (*QuoteOrderResponse, error)
+	// Checkout confirms an order through a saga that resumes after a restart; a failed checkout is
+	// returned with status CHECKOUT_STATUS_FAILED rather than as an error
+	Checkout(context.Context, *CheckoutRequest) (*CheckoutResponse, error)
+	// GetCheckout returns the latest checkout of an order
+	GetCheckout(context.Context, *GetCheckoutRequest) (*CheckoutSaga, error)
+	// StreamOrders sends every matching order with its items; page_size sets the database batch size
+	StreamOrders(*GetAllOrdersRequest, OrderService_StreamOrdersServer) error
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -136,6 +239,21 @@ func (UnimplementedOrderServiceServer) GetAllOrders(context.Context, *GetAllOrde
 func (UnimplementedOrderServiceServer) UpdateOrderStatusByOrderId(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatusByOrderId not implemented")
 }
+func (UnimplementedOrderServiceServer) TransitionOrder(context.Context, *TransitionOrderRequest) (*TransitionOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) QuoteOrder(context.Context, *QuoteOrderRequest) (*QuoteOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) Checkout(context.Context, *CheckoutRequest) (*CheckoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkout not implemented")
+}
+func (UnimplementedOrderServiceServer) GetCheckout(context.Context, *GetCheckoutRequest) (*CheckoutSaga, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCheckout not implemented")
+}
+func (UnimplementedOrderServiceServer) StreamOrders(*GetAllOrdersRequest, OrderService_StreamOrdersServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOrders not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 
 // UnsafeOrderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -257,6 +375,99 @@ func _OrderService_UpdateOrderStatusByOrderId_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_TransitionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).TransitionOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_TransitionOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).TransitionOrder(ctx, req.(*TransitionOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_QuoteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).QuoteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_QuoteOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).QuoteOrder(ctx, req.(*QuoteOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_Checkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).Checkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_Checkout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).Checkout(ctx, req.(*CheckoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetCheckout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCheckoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetCheckout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetCheckout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetCheckout(ctx, req.(*GetCheckoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_StreamOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAllOrdersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderServiceServer).StreamOrders(m, &orderServiceStreamOrdersServer{stream})
+}
+
+type OrderService_StreamOrdersServer interface {
+	Send(*OrderResponse1) error
+	grpc.ServerStream
+}
+
+type orderServiceStreamOrdersServer struct {
+	grpc.ServerStream
+}
+
+func (x *orderServiceStreamOrdersServer) Send(m *OrderResponse1) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -288,7 +499,29 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOrderStatusByOrderId",
 			Handler:    _OrderService_UpdateOrderStatusByOrderId_Handler,
 		},
+		{
+			MethodName: "TransitionOrder",
+			Handler:    _OrderService_TransitionOrder_Handler,
+		},
+		{
+			MethodName: "QuoteOrder",
+			Handler:    _OrderService_QuoteOrder_Handler,
+		},
+		{
+			MethodName: "Checkout",
+			Handler:    _OrderService_Checkout_Handler,
+		},
+		{
+			MethodName: "GetCheckout",
+			Handler:    _OrderService_GetCheckout_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamOrders",
+			Handler:       _OrderService_StreamOrders_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "oms_order.proto",
 }
@@ -0,0 +1,335 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: oms_returns.proto
+
+package protobuf
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ReturnService_RequestReturn_FullMethodName       = "/ReturnService/RequestReturn"
+	ReturnService_ApproveReturn_FullMethodName       = "/ReturnService/ApproveReturn"
+	ReturnService_RejectReturn_FullMethodName        = "/ReturnService/RejectReturn"
+	ReturnService_ReceiveReturn_FullMethodName       = "/ReturnService/ReceiveReturn"
+	ReturnService_RefundReturn_FullMethodName        = "/ReturnService/RefundReturn"
+	ReturnService_GetReturn_FullMethodName           = "/ReturnService/GetReturn"
+	ReturnService_GetReturnsByOrderId_FullMethodName = "/ReturnService/GetReturnsByOrderId"
+)
+
+// ReturnServiceClient is the client API for ReturnService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReturnServiceClient interface {
+	RequestReturn(ctx context.Context, in *RequestReturnRequest, opts ...grpc.CallOption) (*Return, error)
+	ApproveReturn(ctx context.Context, in *ApproveReturnRequest, opts ...grpc.CallOption) (*Return, error)
+	RejectReturn(ctx context.Context, in *RejectReturnRequest, opts ...grpc.CallOption) (*Return, error)
+	ReceiveReturn(ctx context.Context, in *ReceiveReturnRequest, opts ...grpc.CallOption) (*Return, error)
+	// RefundReturn gives the refund amount back through the payment provider, from the order's captured
+	// payment; the return is only refunded once the provider made the refund
+	RefundReturn(ctx context.Context, in *RefundReturnRequest, opts ...grpc.CallOption) (*Return, error)
+	GetReturn(ctx context.Context, in *GetReturnRequest, opts ...grpc.CallOption) (*Return, error)
+	GetReturnsByOrderId(ctx context.Context, in *GetReturnsByOrderIdRequest, opts ...grpc.CallOption) (*GetReturnsResponse, error)
+}
+
+type returnServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReturnServiceClient(cc grpc.ClientConnInterface) ReturnServiceClient {
+	return &returnServiceClient{cc}
+}
+
+func (c *returnServiceClient) RequestReturn(ctx context.Context, in *RequestReturnRequest, opts ...grpc.CallOption) (*Return, error) {
+	out := new(Return)
+	err := c.cc.Invoke(ctx, ReturnService_RequestReturn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *returnServiceClient) ApproveReturn(ctx context.Context, in *ApproveReturnRequest, opts ...grpc.CallOption) (*Return, error) {
+	out := new(Return)
+	err := c.cc.Invoke(ctx, ReturnService_ApproveReturn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *returnServiceClient) RejectReturn(ctx context.Context, in *RejectReturnRequest, opts ...grpc.CallOption) (*Return, error) {
+	out := new(Return)
+	err := c.cc.Invoke(ctx, ReturnService_RejectReturn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *returnServiceClient) ReceiveReturn(ctx context.Context, in *ReceiveReturnRequest, opts ...grpc.CallOption) (*Return, error) {
+	out := new(Return)
+	err := c.cc.Invoke(ctx, ReturnService_ReceiveReturn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *returnServiceClient) RefundReturn(ctx context.Context, in *RefundReturnRequest, opts ...grpc.CallOption) (*Return, error) {
+	out := new(Return)
+	err := c.cc.Invoke(ctx, ReturnService_RefundReturn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *returnServiceClient) GetReturn(ctx context.Context, in *GetReturnRequest, opts ...grpc.CallOption) (*Return, error) {
+	out := new(Return)
+	err := c.cc.Invoke(ctx, ReturnService_GetReturn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *returnServiceClient) GetReturnsByOrderId(ctx context.Context, in *GetReturnsByOrderIdRequest, opts ...grpc.CallOption) (*GetReturnsResponse, error) {
+	out := new(GetReturnsResponse)
+	err := c.cc.Invoke(ctx, ReturnService_GetReturnsByOrderId_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReturnServiceServer is the server API for ReturnService service.
+// All implementations must embed UnimplementedReturnServiceServer
+// for forward compatibility
+type ReturnServiceServer interface {
+	RequestReturn(context.Context, *RequestReturnRequest) (*Return, error)
+	ApproveReturn(context.Context, *ApproveReturnRequest) (*Return, error)
+	RejectReturn(context.Context, *RejectReturnRequest) (*Return, error)
+	ReceiveReturn(context.Context, *ReceiveReturnRequest) (*Return, error)
+	// RefundReturn gives the refund amount back through the payment provider, from the order's captured
+	// payment; the return is only refunded once the provider made the refund
+	RefundReturn(context.Context, *RefundReturnRequest) (*Return, error)
+	GetReturn(context.Context, *GetReturnRequest) (*Return, error)
+	GetReturnsByOrderId(context.Context, *GetReturnsByOrderIdRequest) (*GetReturnsResponse, error)
+	mustEmbedUnimplementedReturnServiceServer()
+}
+
+// UnimplementedReturnServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedReturnServiceServer struct {
+}
+
+func (UnimplementedReturnServiceServer) RequestReturn(context.Context, *RequestReturnRequest) (*Return, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReturn not implemented")
+}
+func (UnimplementedReturnServiceServer) ApproveReturn(context.Context, *ApproveReturnRequest) (*Return, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveReturn not implemented")
+}
+func (UnimplementedReturnServiceServer) RejectReturn(context.Context, *RejectReturnRequest) (*Return, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectReturn not implemented")
+}
+func (UnimplementedReturnServiceServer) ReceiveReturn(context.Context, *ReceiveReturnRequest) (*Return, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveReturn not implemented")
+}
+func (UnimplementedReturnServiceServer) RefundReturn(context.Context, *RefundReturnRequest) (*Return, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundReturn not implemented")
+}
+func (UnimplementedReturnServiceServer) GetReturn(context.Context, *GetReturnRequest) (*Return, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReturn not implemented")
+}
+func (UnimplementedReturnServiceServer) GetReturnsByOrderId(context.Context, *GetReturnsByOrderIdRequest) (*GetReturnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReturnsByOrderId not implemented")
+}
+func (UnimplementedReturnServiceServer) mustEmbedUnimplementedReturnServiceServer() {}
+
+// UnsafeReturnServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReturnServiceServer will
+// result in compilation errors.
+type UnsafeReturnServiceServer interface {
+	mustEmbedUnimplementedReturnServiceServer()
+}
+
+func RegisterReturnServiceServer(s grpc.ServiceRegistrar, srv ReturnServiceServer) {
+	s.RegisterService(&ReturnService_ServiceDesc, srv)
+}
+
+func _ReturnService_RequestReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReturnServiceServer).RequestReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReturnService_RequestReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReturnServiceServer).RequestReturn(ctx, req.(*RequestReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReturnService_ApproveReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReturnServiceServer).ApproveReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReturnService_ApproveReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReturnServiceServer).ApproveReturn(ctx, req.(*ApproveReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReturnService_RejectReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReturnServiceServer).RejectReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReturnService_RejectReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReturnServiceServer).RejectReturn(ctx, req.(*RejectReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReturnService_ReceiveReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReturnServiceServer).ReceiveReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReturnService_ReceiveReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReturnServiceServer).ReceiveReturn(ctx, req.(*ReceiveReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReturnService_RefundReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReturnServiceServer).RefundReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReturnService_RefundReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReturnServiceServer).RefundReturn(ctx, req.(*RefundReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReturnService_GetReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReturnServiceServer).GetReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReturnService_GetReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReturnServiceServer).GetReturn(ctx, req.(*GetReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReturnService_GetReturnsByOrderId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReturnsByOrderIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReturnServiceServer).GetReturnsByOrderId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReturnService_GetReturnsByOrderId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReturnServiceServer).GetReturnsByOrderId(ctx, req.(*GetReturnsByOrderIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReturnService_ServiceDesc is the grpc.ServiceDesc for ReturnService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReturnService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ReturnService",
+	HandlerType: (*ReturnServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestReturn",
+			Handler:    _ReturnService_RequestReturn_Handler,
+		},
+		{
+			MethodName: "ApproveReturn",
+			Handler:    _ReturnService_ApproveReturn_Handler,
+		},
+		{
+			MethodName: "RejectReturn",
+			Handler:    _ReturnService_RejectReturn_Handler,
+		},
+		{
+			MethodName: "ReceiveReturn",
+			Handler:    _ReturnService_ReceiveReturn_Handler,
+		},
+		{
+			MethodName: "RefundReturn",
+			Handler:    _ReturnService_RefundReturn_Handler,
+		},
+		{
+			MethodName: "GetReturn",
+			Handler:    _ReturnService_GetReturn_Handler,
+		},
+		{
+			MethodName: "GetReturnsByOrderId",
+			Handler:    _ReturnService_GetReturnsByOrderId_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "oms_returns.proto",
+}
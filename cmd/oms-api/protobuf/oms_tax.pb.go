@@ -0,0 +1,729 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.21.12
+// source: oms_tax.proto
+
+package protobuf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TaxRate is the rate a jurisdiction charges on items of a tax category
+type TaxRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaxCategory string  `protobuf:"bytes,1,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"` // Empty for the standard rate, which applies to categories without a rate of their own
+	Rate        float64 `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`                                // Percentage, e.g. 7.25 for 7.25%
+}
+
+func (x *TaxRate) Reset() {
+	*x = TaxRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_tax_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaxRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxRate) ProtoMessage() {}
+
+func (x *TaxRate) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_tax_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxRate.ProtoReflect.Descriptor instead.
+func (*TaxRate) Descriptor() ([]byte, []int) {
+	return file_oms_tax_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TaxRate) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+func (x *TaxRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+// TaxJurisdiction collects the tax rates of a shipping region
+type TaxJurisdiction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Region string     `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"` // Region code, e.g. "US-CA"; a country code such as "DE" covers all its regions without a jurisdiction of their own
+	Name   string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rates  []*TaxRate `protobuf:"bytes,3,rep,name=rates,proto3" json:"rates,omitempty"` // Output only; managed with SetTaxRate
+}
+
+func (x *TaxJurisdiction) Reset() {
+	*x = TaxJurisdiction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_tax_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaxJurisdiction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxJurisdiction) ProtoMessage() {}
+
+func (x *TaxJurisdiction) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_tax_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxJurisdiction.ProtoReflect.Descriptor instead.
+func (*TaxJurisdiction) Descriptor() ([]byte, []int) {
+	return file_oms_tax_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TaxJurisdiction) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *TaxJurisdiction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaxJurisdiction) GetRates() []*TaxRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type SetTaxJurisdictionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jurisdiction *TaxJurisdiction `protobuf:"bytes,1,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+}
+
+func (x *SetTaxJurisdictionRequest) Reset() {
+	*x = SetTaxJurisdictionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_tax_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTaxJurisdictionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaxJurisdictionRequest) ProtoMessage() {}
+
+func (x *SetTaxJurisdictionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_tax_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaxJurisdictionRequest.ProtoReflect.Descriptor instead.
+func (*SetTaxJurisdictionRequest) Descriptor() ([]byte, []int) {
+	return file_oms_tax_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetTaxJurisdictionRequest) GetJurisdiction() *TaxJurisdiction {
+	if x != nil {
+		return x.Jurisdiction
+	}
+	return nil
+}
+
+type GetAllTaxJurisdictionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetAllTaxJurisdictionsRequest) Reset() {
+	*x = GetAllTaxJurisdictionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_tax_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllTaxJurisdictionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllTaxJurisdictionsRequest) ProtoMessage() {}
+
+func (x *GetAllTaxJurisdictionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_tax_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllTaxJurisdictionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllTaxJurisdictionsRequest) Descriptor() ([]byte, []int) {
+	return file_oms_tax_proto_rawDescGZIP(), []int{3}
+}
+
+type GetAllTaxJurisdictionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jurisdictions []*TaxJurisdiction `protobuf:"bytes,1,rep,name=jurisdictions,proto3" json:"jurisdictions,omitempty"`
+}
+
+func (x *GetAllTaxJurisdictionsResponse) Reset() {
+	*x = GetAllTaxJurisdictionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_tax_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllTaxJurisdictionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllTaxJurisdictionsResponse) ProtoMessage() {}
+
+func (x *GetAllTaxJurisdictionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_tax_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllTaxJurisdictionsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllTaxJurisdictionsResponse) Descriptor() ([]byte, []int) {
+	return file_oms_tax_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAllTaxJurisdictionsResponse) GetJurisdictions() []*TaxJurisdiction {
+	if x != nil {
+		return x.Jurisdictions
+	}
+	return nil
+}
+
+type DeleteTaxJurisdictionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+}
+
+func (x *DeleteTaxJurisdictionRequest) Reset() {
+	*x = DeleteTaxJurisdictionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_tax_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTaxJurisdictionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaxJurisdictionRequest) ProtoMessage() {}
+
+func (x *DeleteTaxJurisdictionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_tax_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaxJurisdictionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaxJurisdictionRequest) Descriptor() ([]byte, []int) {
+	return file_oms_tax_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteTaxJurisdictionRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type DeleteTaxJurisdictionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // Success or error message
+}
+
+func (x *DeleteTaxJurisdictionResponse) Reset() {
+	*x = DeleteTaxJurisdictionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_tax_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTaxJurisdictionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaxJurisdictionResponse) ProtoMessage() {}
+
+func (x *DeleteTaxJurisdictionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_tax_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaxJurisdictionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaxJurisdictionResponse) Descriptor() ([]byte, []int) {
+	return file_oms_tax_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteTaxJurisdictionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SetTaxRateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Region string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"` // Region of an existing jurisdiction
+	Rate   *TaxRate `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *SetTaxRateRequest) Reset() {
+	*x = SetTaxRateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_tax_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTaxRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaxRateRequest) ProtoMessage() {}
+
+func (x *SetTaxRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_tax_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaxRateRequest.ProtoReflect.Descriptor instead.
+func (*SetTaxRateRequest) Descriptor() ([]byte, []int) {
+	return file_oms_tax_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetTaxRateRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *SetTaxRateRequest) GetRate() *TaxRate {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+type DeleteTaxRateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Region      string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	TaxCategory string `protobuf:"bytes,2,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+}
+
+func (x *DeleteTaxRateRequest) Reset() {
+	*x = DeleteTaxRateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oms_tax_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTaxRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaxRateRequest) ProtoMessage() {}
+
+func (x *DeleteTaxRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oms_tax_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaxRateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaxRateRequest) Descriptor() ([]byte, []int) {
+	return file_oms_tax_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteTaxRateRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *DeleteTaxRateRequest) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+var File_oms_tax_proto protoreflect.FileDescriptor
+
+var file_oms_tax_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x6f, 0x6d, 0x73, 0x5f, 0x74, 0x61, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x40, 0x0a, 0x07, 0x54, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61,
+	0x78, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x74, 0x61, 0x78, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74,
+	0x65, 0x22, 0x5d, 0x0a, 0x0f, 0x54, 0x61, 0x78, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x54, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x22, 0x51, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x54, 0x61, 0x78, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a,
+	0x0c, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x61, 0x78, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x1f, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x78,
+	0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x61,
+	0x78, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x54, 0x61, 0x78, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0d, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x36,
+	0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x78, 0x4a, 0x75, 0x72, 0x69, 0x73,
+	0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x61, 0x78, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x49, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x54, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x54,
+	0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x51, 0x0a, 0x14,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x78, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x32,
+	0xf1, 0x02, 0x0a, 0x0a, 0x54, 0x61, 0x78, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42,
+	0x0a, 0x12, 0x53, 0x65, 0x74, 0x54, 0x61, 0x78, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x78, 0x4a, 0x75, 0x72,
+	0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x54, 0x61, 0x78, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x59, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x78, 0x4a,
+	0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x78, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x78, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x78, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x61, 0x78, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61,
+	0x78, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x54, 0x61, 0x78, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x54, 0x61, 0x78, 0x4a, 0x75, 0x72,
+	0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x54, 0x61, 0x78, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_oms_tax_proto_rawDescOnce sync.Once
+	file_oms_tax_proto_rawDescData = file_oms_tax_proto_rawDesc
+)
+
+func file_oms_tax_proto_rawDescGZIP() []byte {
+	file_oms_tax_proto_rawDescOnce.Do(func() {
+		file_oms_tax_proto_rawDescData = protoimpl.X.CompressGZIP(file_oms_tax_proto_rawDescData)
+	})
+	return file_oms_tax_proto_rawDescData
+}
+
+var file_oms_tax_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_oms_tax_proto_goTypes = []interface{}{
+	(*TaxRate)(nil),                        // 0: TaxRate
+	(*TaxJurisdiction)(nil),                // 1: TaxJurisdiction
+	(*SetTaxJurisdictionRequest)(nil),      // 2: SetTaxJurisdictionRequest
+	(*GetAllTaxJurisdictionsRequest)(nil),  // 3: GetAllTaxJurisdictionsRequest
+	(*GetAllTaxJurisdictionsResponse)(nil), // 4: GetAllTaxJurisdictionsResponse
+	(*DeleteTaxJurisdictionRequest)(nil),   // 5: DeleteTaxJurisdictionRequest
+	(*DeleteTaxJurisdictionResponse)(nil),  // 6: DeleteTaxJurisdictionResponse
+	(*SetTaxRateRequest)(nil),              // 7: SetTaxRateRequest
+	(*DeleteTaxRateRequest)(nil),           // 8: DeleteTaxRateRequest
+}
+var file_oms_tax_proto_depIdxs = []int32{
+	0, // 0: TaxJurisdiction.rates:type_name -> TaxRate
+	1, // 1: SetTaxJurisdictionRequest.jurisdiction:type_name -> TaxJurisdiction
+	1, // 2: GetAllTaxJurisdictionsResponse.jurisdictions:type_name -> TaxJurisdiction
+	0, // 3: SetTaxRateRequest.rate:type_name -> TaxRate
+	2, // 4: TaxService.SetTaxJurisdiction:input_type -> SetTaxJurisdictionRequest
+	3, // 5: TaxService.GetAllTaxJurisdictions:input_type -> GetAllTaxJurisdictionsRequest
+	5, // 6: TaxService.DeleteTaxJurisdiction:input_type -> DeleteTaxJurisdictionRequest
+	7, // 7: TaxService.SetTaxRate:input_type -> SetTaxRateRequest
+	8, // 8: TaxService.DeleteTaxRate:input_type -> DeleteTaxRateRequest
+	1, // 9: TaxService.SetTaxJurisdiction:output_type -> TaxJurisdiction
+	4, // 10: TaxService.GetAllTaxJurisdictions:output_type -> GetAllTaxJurisdictionsResponse
+	6, // 11: TaxService.DeleteTaxJurisdiction:output_type -> DeleteTaxJurisdictionResponse
+	1, // 12: TaxService.SetTaxRate:output_type -> TaxJurisdiction
+	1, // 13: TaxService.DeleteTaxRate:output_type -> TaxJurisdiction
+	9, // [9:14] is the sub-list for method output_type
+	4, // [4:9] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_oms_tax_proto_init() }
+func file_oms_tax_proto_init() {
+	if File_oms_tax_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_oms_tax_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaxRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_tax_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaxJurisdiction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_tax_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTaxJurisdictionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_tax_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllTaxJurisdictionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_tax_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllTaxJurisdictionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_tax_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTaxJurisdictionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_tax_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTaxJurisdictionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_tax_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTaxRateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oms_tax_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTaxRateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_oms_tax_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_oms_tax_proto_goTypes,
+		DependencyIndexes: file_oms_tax_proto_depIdxs,
+		MessageInfos:      file_oms_tax_proto_msgTypes,
+	}.Build()
+	File_oms_tax_proto = out.File
+	file_oms_tax_proto_rawDesc = nil
+	file_oms_tax_proto_goTypes = nil
+	file_oms_tax_proto_depIdxs = nil
+}
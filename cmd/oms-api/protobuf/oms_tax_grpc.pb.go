@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: oms_tax.proto
+
+package protobuf
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	TaxService_SetTaxJurisdiction_FullMethodName     = "/TaxService/SetTaxJurisdiction"
+	TaxService_GetAllTaxJurisdictions_FullMethodName = "/TaxService/GetAllTaxJurisdictions"
+	TaxService_DeleteTaxJurisdiction_FullMethodName  = "/TaxService/DeleteTaxJurisdiction"
+	TaxService_SetTaxRate_FullMethodName             = "/TaxService/SetTaxRate"
+	TaxService_DeleteTaxRate_FullMethodName          = "/TaxService/DeleteTaxRate"
+)
+
+// TaxServiceClient is the client API for TaxService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TaxServiceClient interface {
+	SetTaxJurisdiction(ctx context.Context, in *SetTaxJurisdictionRequest, opts ...grpc.CallOption) (*TaxJurisdiction, error)
+	GetAllTaxJurisdictions(ctx context.Context, in *GetAllTaxJurisdictionsRequest, opts ...grpc.CallOption) (*GetAllTaxJurisdictionsResponse, error)
+	DeleteTaxJurisdiction(ctx context.Context, in *DeleteTaxJurisdictionRequest, opts ...grpc.CallOption) (*DeleteTaxJurisdictionResponse, error)
+	SetTaxRate(ctx context.Context, in *SetTaxRateRequest, opts ...grpc.CallOption) (*TaxJurisdiction, error)
+	DeleteTaxRate(ctx context.Context, in *DeleteTaxRateRequest, opts ...grpc.CallOption) (*TaxJurisdiction, error)
+}
+
+type taxServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaxServiceClient(cc grpc.ClientConnInterface) TaxServiceClient {
+	return &taxServiceClient{cc}
+}
+
+func (c *taxServiceClient) SetTaxJurisdiction(ctx context.Context, in *SetTaxJurisdictionRequest, opts ...grpc.CallOption) (*TaxJurisdiction, error) {
+	out := new(TaxJurisdiction)
+	err := c.cc.Invoke(ctx, TaxService_SetTaxJurisdiction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxServiceClient) GetAllTaxJurisdictions(ctx context.Context, in *GetAllTaxJurisdictionsRequest, opts ...grpc.CallOption) (*GetAllTaxJurisdictionsResponse, error) {
+	out := new(GetAllTaxJurisdictionsResponse)
+	err := c.cc.Invoke(ctx, TaxService_GetAllTaxJurisdictions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxServiceClient) DeleteTaxJurisdiction(ctx context.Context, in *DeleteTaxJurisdictionRequest, opts ...grpc.CallOption) (*DeleteTaxJurisdictionResponse, error) {
+	out := new(DeleteTaxJurisdictionResponse)
+	err := c.cc.Invoke(ctx, TaxService_DeleteTaxJurisdiction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxServiceClient) SetTaxRate(ctx context.Context, in *SetTaxRateRequest, opts ...grpc.CallOption) (*TaxJurisdiction, error) {
+	out := new(TaxJurisdiction)
+	err := c.cc.Invoke(ctx, TaxService_SetTaxRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxServiceClient) DeleteTaxRate(ctx context.Context, in *DeleteTaxRateRequest, opts ...grpc.CallOption) (*TaxJurisdiction, error) {
+	out := new(TaxJurisdiction)
+	err := c.cc.Invoke(ctx, TaxService_DeleteTaxRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaxServiceServer is the server API for TaxService service.
+// All implementations must embed UnimplementedTaxServiceServer
+// for forward compatibility
+type TaxServiceServer interface {
+	SetTaxJurisdiction(context.Context, *SetTaxJurisdictionRequest) (*TaxJurisdiction, error)
+	GetAllTaxJurisdictions(context.Context, *GetAllTaxJurisdictionsRequest) (*GetAllTaxJurisdictionsResponse, error)
+	DeleteTaxJurisdiction(context.Context, *DeleteTaxJurisdictionRequest) (*DeleteTaxJurisdictionResponse, error)
+	SetTaxRate(context.Context, *SetTaxRateRequest) (*TaxJurisdiction, error)
+	DeleteTaxRate(context.Context, *DeleteTaxRateRequest) (*TaxJurisdiction, error)
+	mustEmbedUnimplementedTaxServiceServer()
+}
+
+// UnimplementedTaxServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTaxServiceServer struct {
+}
+
+func (UnimplementedTaxServiceServer) SetTaxJurisdiction(context.Context, *SetTaxJurisdictionRequest) (*TaxJurisdiction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTaxJurisdiction not implemented")
+}
+func (UnimplementedTaxServiceServer) GetAllTaxJurisdictions(context.Context, *GetAllTaxJurisdictionsRequest) (*GetAllTaxJurisdictionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllTaxJurisdictions not implemented")
+}
+func (UnimplementedTaxServiceServer) DeleteTaxJurisdiction(context.Context, *DeleteTaxJurisdictionRequest) (*DeleteTaxJurisdictionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaxJurisdiction not implemented")
+}
+func (UnimplementedTaxServiceServer) SetTaxRate(context.Context, *SetTaxRateRequest) (*TaxJurisdiction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTaxRate not implemented")
+}
+func (UnimplementedTaxServiceServer) DeleteTaxRate(context.Context, *DeleteTaxRateRequest) (*TaxJurisdiction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaxRate not implemented")
+}
+func (UnimplementedTaxServiceServer) mustEmbedUnimplementedTaxServiceServer() {}
+
+// UnsafeTaxServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaxServiceServer will
+// result in compilation errors.
+type UnsafeTaxServiceServer interface {
+	mustEmbedUnimplementedTaxServiceServer()
+}
+
+func RegisterTaxServiceServer(s grpc.ServiceRegistrar, srv TaxServiceServer) {
+	s.RegisterService(&TaxService_ServiceDesc, srv)
+}
+
+func _TaxService_SetTaxJurisdiction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaxJurisdictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxServiceServer).SetTaxJurisdiction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxService_SetTaxJurisdiction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxServiceServer).SetTaxJurisdiction(ctx, req.(*SetTaxJurisdictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxService_GetAllTaxJurisdictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllTaxJurisdictionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxServiceServer).GetAllTaxJurisdictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxService_GetAllTaxJurisdictions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxServiceServer).GetAllTaxJurisdictions(ctx, req.(*GetAllTaxJurisdictionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxService_DeleteTaxJurisdiction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaxJurisdictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxServiceServer).DeleteTaxJurisdiction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxService_DeleteTaxJurisdiction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxServiceServer).DeleteTaxJurisdiction(ctx, req.(*DeleteTaxJurisdictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxService_SetTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxServiceServer).SetTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxService_SetTaxRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxServiceServer).SetTaxRate(ctx, req.(*SetTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxService_DeleteTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxServiceServer).DeleteTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxService_DeleteTaxRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxServiceServer).DeleteTaxRate(ctx, req.(*DeleteTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaxService_ServiceDesc is the grpc.ServiceDesc for TaxService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaxService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "TaxService",
+	HandlerType: (*TaxServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetTaxJurisdiction",
+			Handler:    _TaxService_SetTaxJurisdiction_Handler,
+		},
+		{
+			MethodName: "GetAllTaxJurisdictions",
+			Handler:    _TaxService_GetAllTaxJurisdictions_Handler,
+		},
+		{
+			MethodName: "DeleteTaxJurisdiction",
+			Handler:    _TaxService_DeleteTaxJurisdiction_Handler,
+		},
+		{
+			MethodName: "SetTaxRate",
+			Handler:    _TaxService_SetTaxRate_Handler,
+		},
+		{
+			MethodName: "DeleteTaxRate",
+			Handler:    _TaxService_DeleteTaxRate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "oms_tax.proto",
+}
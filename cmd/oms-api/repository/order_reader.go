@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	"gorm.io/gorm"
+)
+
+// OrderReader loads orders together with their line items, adjustments, exchange rates, addresses and shipments.
+// Whatever the number of orders, each kind of detail is read with one query for all of them (two for shipments
+// and their items), instead of one query per order.
+type OrderReader struct {
+	DB *gorm.DB
+}
+
+// NewOrderReader creates an OrderReader on db
+func NewOrderReader(db *gorm.DB) *OrderReader {
+	return &OrderReader{DB: db}
+}
+
+// ListByUser returns the non-deleted orders of a user, oldest first, with their items loaded
+func (r *OrderReader) ListByUser(ctx context.Context, userID int32, withItemNames bool) ([]models.Order, error) {
+	var orders []models.Order
+	if err := r.DB.WithContext(ctx).Where("user_id = ?", userID).Order("created_at, id").Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	if err := r.LoadItems(ctx, orders, withItemNames); err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// LoadItems fills the Items of every order with a single query. Soft-deleted order items are skipped.
+// With withItemNames the current item name, including for items deleted since, is joined in.
+func (r *OrderReader) LoadItems(ctx context.Context, orders []models.Order, withItemNames bool) error {
+	return loadByOrder(orders, func(order *models.Order) *[]models.OrderItem { return &order.Items },
+		func(item *models.OrderItem) int32 { return item.OrderID },
+		func(orderIDs []int32) *gorm.DB {
+			query := r.DB.WithContext(ctx).
+				Table("order_items").
+				Where("order_items.order_id IN ? AND order_items.deleted_at IS NULL", orderIDs).
+				Order("order_items.order_id, order_items.id")
+			if withItemNames {
+				return query.
+					Select("order_items.*, items.name AS item_name").
+					Joins("LEFT JOIN items ON items.id = order_items.item_id")
+			}
+			return query.Select("order_items.*")
+		})
+}
+
+// LoadShipments fills the Shipments of every order, with their items, in two queries
+func (r *OrderReader) LoadShipments(ctx context.Context, orders []models.Order) error {
+	return loadByOrder(orders, func(order *models.Order) *[]models.Shipment { return &order.Shipments },
+		func(shipment *models.Shipment) int32 { return shipment.OrderID },
+		func(orderIDs []int32) *gorm.DB { return r.byOrder(ctx, orderIDs).Preload("Items") })
+}
+
+// LoadAddresses fills the Addresses of every order with a single query
+func (r *OrderReader) LoadAddresses(ctx context.Context, orders []models.Order) error {
+	return loadByOrder(orders, func(order *models.Order) *[]models.OrderAddress { return &order.Addresses },
+		func(address *models.OrderAddress) int32 { return address.OrderID },
+		func(orderIDs []int32) *gorm.DB { return r.byOrder(ctx, orderIDs) })
+}
+
+// LoadExchangeRates fills the ExchangeRates of every order with a single query
+func (r *OrderReader) LoadExchangeRates(ctx context.Context, orders []models.Order) error {
+	return loadByOrder(orders, func(order *models.Order) *[]models.OrderExchangeRate { return &order.ExchangeRates },
+		func(rate *models.OrderExchangeRate) int32 { return rate.OrderID },
+		func(orderIDs []int32) *gorm.DB { return r.byOrder(ctx, orderIDs) })
+}
+
+// LoadAdjustments fills the Adjustments of every order with a single query
+func (r *OrderReader) LoadAdjustments(ctx context.Context, orders []models.Order) error {
+	return loadByOrder(orders, func(order *models.Order) *[]models.OrderAdjustment { return &order.Adjustments },
+		func(adjustment *models.OrderAdjustment) int32 { return adjustment.OrderID },
+		func(orderIDs []int32) *gorm.DB { return r.byOrder(ctx, orderIDs) })
+}
+
+// byOrder queries the rows belonging to the orders, grouped by order in insertion order
+func (r *OrderReader) byOrder(ctx context.Context, orderIDs []int32) *gorm.DB {
+	return r.DB.WithContext(ctx).Where("order_id IN ?", orderIDs).Order("order_id, id")
+}
+
+// loadByOrder replaces the rows field points to on every order with the rows query finds for all of
+// the orders at once; orderID tells which order a row belongs to
+func loadByOrder[T any](orders []models.Order, field func(*models.Order) *[]T, orderID func(*T) int32, query func(orderIDs []int32) *gorm.DB) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	orderIDs := make([]int32, len(orders))
+	byID := make(map[int32]*models.Order, len(orders))
+	for i := range orders {
+		orderIDs[i] = orders[i].ID
+		byID[orders[i].ID] = &orders[i]
+		*field(&orders[i]) = nil
+	}
+
+	var rows []T
+	if err := query(orderIDs).Find(&rows).Error; err != nil {
+		return err
+	}
+	for i := range rows {
+		rowsOfOrder := field(byID[orderID(&rows[i])])
+		*rowsOfOrder = append(*rowsOfOrder, rows[i])
+	}
+	return nil
+}
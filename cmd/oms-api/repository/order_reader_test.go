@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/keyurKalariya/OMS/cmd/oms-api/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// loaderQueries is how many queries loading every detail of a batch of orders takes, whatever its size:
+// items, adjustments, exchange rates, addresses, shipments and shipment items
+const loaderQueries = 6
+
+// loadDetails loads every detail of orders the way the order handlers do
+func loadDetails(ctx context.Context, reader *OrderReader, orders []models.Order) error {
+	if err := reader.LoadItems(ctx, orders, true); err != nil {
+		return err
+	}
+	if err := reader.LoadAdjustments(ctx, orders); err != nil {
+		return err
+	}
+	if err := reader.LoadExchangeRates(ctx, orders); err != nil {
+		return err
+	}
+	if err := reader.LoadAddresses(ctx, orders); err != nil {
+		return err
+	}
+	return reader.LoadShipments(ctx, orders)
+}
+
+// countLoadQueries loads the details of n orders, each with one shipment of one item, from a mocked
+// database and returns how many statements were run
+func countLoadQueries(t *testing.T, n int) int {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("creating mock database: %v", err)
+	}
+	defer sqlDB.Close()
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("opening database: %v", err)
+	}
+
+	statements := 0
+	if err := db.Callback().Query().After("gorm:query").Register("test:count_queries", func(*gorm.DB) {
+		statements++
+	}); err != nil {
+		t.Fatalf("registering callback: %v", err)
+	}
+
+	orders := make([]models.Order, n)
+	shipments := sqlmock.NewRows([]string{"id", "order_id"})
+	shipmentItems := sqlmock.NewRows([]string{"id", "shipment_id", "item_id", "quantity"})
+	for i := range orders {
+		orders[i].ID = int32(i + 1)
+		shipments.AddRow(i+1, i+1)
+		shipmentItems.AddRow(i+1, i+1, 1, 1)
+	}
+	mock.ExpectQuery(`FROM "?order_items"?`).WillReturnRows(sqlmock.NewRows([]string{"id", "order_id"}))
+	mock.ExpectQuery(`FROM "order_adjustments"`).WillReturnRows(sqlmock.NewRows([]string{"id", "order_id"}))
+	mock.ExpectQuery(`FROM "order_exchange_rates"`).WillReturnRows(sqlmock.NewRows([]string{"id", "order_id"}))
+	mock.ExpectQuery(`FROM "order_addresses"`).WillReturnRows(sqlmock.NewRows([]string{"id", "order_id"}))
+	mock.ExpectQuery(`FROM "shipments"`).WillReturnRows(shipments)
+	mock.ExpectQuery(`FROM "shipment_items"`).WillReturnRows(shipmentItems)
+
+	if err := loadDetails(context.Background(), NewOrderReader(db), orders); err != nil {
+		t.Fatalf("loading %d orders: %v", n, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("loading %d orders: %v", n, err)
+	}
+	for i := range orders {
+		if len(orders[i].Shipments) != 1 || len(orders[i].Shipments[0].Items) != 1 {
+			t.Fatalf("order %d got shipments %+v, want one with one item", orders[i].ID, orders[i].Shipments)
+		}
+	}
+	return statements
+}
+
+func TestOrderReaderQueriesDoNotGrowWithPageSize(t *testing.T) {
+	small, large := countLoadQueries(t, 1), countLoadQueries(t, 100)
+	if small != loaderQueries || large != loaderQueries {
+		t.Errorf("loading 1 order took %d queries and 100 orders took %d, want %d for both", small, large, loaderQueries)
+	}
+}